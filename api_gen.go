@@ -0,0 +1,2044 @@
+// Code generated by internal/apigen from spec/api.json. DO NOT EDIT.
+
+package voiceit2
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/voiceittech/VoiceIt2-Go/v2/structs"
+)
+
+// GetAllUsers returns a list of all users associated with the API Key
+// For more details see https://api.voiceit.io/#get-all-users
+func (vi VoiceIt2) GetAllUsers(opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	req, err := http.NewRequest("GET", vi.BaseUrl+"/users"+vi.query(options, nil), nil)
+	if err != nil {
+		return []byte{}, errors.New("GetAllUsers Exception: " + err.Error())
+	}
+
+	return vi.do(vi.context(), "GetAllUsers", req, options)
+}
+
+// CreateUser creates a new user profile and returns a unique userId
+// that is used for all future calls related to the user profile
+// For more details see https://api.voiceit.io/#create-a-user
+func (vi VoiceIt2) CreateUser(opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/users"+vi.query(options, nil), nil)
+	if err != nil {
+		return []byte{}, errors.New("CreateUser Exception: " + err.Error())
+	}
+
+	return vi.do(vi.context(), "CreateUser", req, options)
+}
+
+// CheckUserExists takes the userId generated during a createUser and returns
+// an object which contains the boolean "exists" which shows whether a given user exists
+// For more details see https://api.voiceit.io/#check-if-a-specific-user-exists
+func (vi VoiceIt2) CheckUserExists(userId string, opts ...CallOption) ([]byte, error) {
+	if err := UserID(userId).Validate(); err != nil {
+		return []byte{}, fmt.Errorf("CheckUserExists Exception: %w", err)
+	}
+
+	options := newCallOptions(opts)
+
+	req, err := http.NewRequest("GET", vi.BaseUrl+"/users/"+url.PathEscape(userId)+vi.query(options, nil), nil)
+	if err != nil {
+		return []byte{}, errors.New("CheckUserExists Exception: " + err.Error())
+	}
+
+	return vi.do(vi.context(), "CheckUserExists", req, options)
+}
+
+// DeleteUser takes the userId generated during a createUser and deletes
+// the user profile and all associated face and voice enrollments
+// For more details see https://api.voiceit.io/#delete-a-specific-user
+func (vi VoiceIt2) DeleteUser(userId string, opts ...CallOption) ([]byte, error) {
+	if err := UserID(userId).Validate(); err != nil {
+		return []byte{}, fmt.Errorf("DeleteUser Exception: %w", err)
+	}
+
+	options := newCallOptions(opts)
+
+	req, err := http.NewRequest("DELETE", vi.BaseUrl+"/users/"+url.PathEscape(userId)+vi.query(options, nil), nil)
+	if err != nil {
+		return []byte{}, errors.New("DeleteUser Exception: " + err.Error())
+	}
+
+	return vi.do(vi.context(), "DeleteUser", req, options)
+}
+
+// GetGroupsForUser takes the userId generated during a createUser and returns
+// a list of all groups that the user belongs to
+// For more details see https://api.voiceit.io/#get-groups-for-user
+func (vi VoiceIt2) GetGroupsForUser(userId string, opts ...CallOption) ([]byte, error) {
+	if err := UserID(userId).Validate(); err != nil {
+		return []byte{}, fmt.Errorf("GetGroupsForUser Exception: %w", err)
+	}
+
+	options := newCallOptions(opts)
+
+	req, err := http.NewRequest("GET", vi.BaseUrl+"/users/"+url.PathEscape(userId)+"/groups"+vi.query(options, nil), nil)
+	if err != nil {
+		return []byte{}, errors.New("GetGroupsForUser Exception: " + err.Error())
+	}
+
+	return vi.do(vi.context(), "GetGroupsForUser", req, options)
+}
+
+// GetAllGroups returns a list of all groups associated with the API Key
+// For more details see https://api.voiceit.io/#get-all-groups
+func (vi VoiceIt2) GetAllGroups(opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	req, err := http.NewRequest("GET", vi.BaseUrl+"/groups"+vi.query(options, nil), nil)
+	if err != nil {
+		return []byte{}, errors.New("GetAllGroups Exception: " + err.Error())
+	}
+
+	return vi.do(vi.context(), "GetAllGroups", req, options)
+}
+
+// GetGroup takes the groupId generated during a createGroup
+// and returns the group along with a list of associated users in the group
+// For more details see https://api.voiceit.io/#get-a-specific-group
+func (vi VoiceIt2) GetGroup(groupId string, opts ...CallOption) ([]byte, error) {
+	if err := GroupID(groupId).Validate(); err != nil {
+		return []byte{}, fmt.Errorf("GetGroup Exception: %w", err)
+	}
+
+	options := newCallOptions(opts)
+
+	req, err := http.NewRequest("GET", vi.BaseUrl+"/groups/"+url.PathEscape(groupId)+vi.query(options, nil), nil)
+	if err != nil {
+		return []byte{}, errors.New("GetGroup Exception: " + err.Error())
+	}
+
+	return vi.do(vi.context(), "GetGroup", req, options)
+}
+
+// CheckGroupExists takes the groupId generated during a createGroup
+// and returns whether the group exists for the given groupId
+// For more details see https://api.voiceit.io/#check-if-group-exists
+func (vi VoiceIt2) CheckGroupExists(groupId string, opts ...CallOption) ([]byte, error) {
+	if err := GroupID(groupId).Validate(); err != nil {
+		return []byte{}, fmt.Errorf("CheckGroupExists Exception: %w", err)
+	}
+
+	options := newCallOptions(opts)
+
+	req, err := http.NewRequest("GET", vi.BaseUrl+"/groups/"+url.PathEscape(groupId)+"/exists"+vi.query(options, nil), nil)
+	if err != nil {
+		return []byte{}, errors.New("CheckGroupExists Exception: " + err.Error())
+	}
+
+	return vi.do(vi.context(), "CheckGroupExists", req, options)
+}
+
+// CreateGroup creates a new group profile and returns a unique groupId
+// that is used for all future calls related to the group
+// For more details see https://api.voiceit.io/#create-a-group
+func (vi VoiceIt2) CreateGroup(description string, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	if err := writer.WriteField("description", description); err != nil {
+		return []byte{}, errors.New("CreateGroup Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/groups"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("CreateGroup Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "CreateGroup", req, options)
+}
+
+// AddUserToGroup takes the groupId generated during a createGroup
+// and the userId generated during createUser and adds the user to the group
+// For more details see https://api.voiceit.io/#add-user-to-group
+func (vi VoiceIt2) AddUserToGroup(groupId, userId string, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	if err := writer.WriteField("groupId", groupId); err != nil {
+		return []byte{}, errors.New("AddUserToGroup Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("userId", userId); err != nil {
+		return []byte{}, errors.New("AddUserToGroup Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("PUT", vi.BaseUrl+"/groups/addUser"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("AddUserToGroup Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "AddUserToGroup", req, options)
+}
+
+// RemoveUserFromGroup takes the groupId generated during a createGroup
+// and the userId generated during createUser and removes the user from the group
+// For more details see https://api.voiceit.io/#remove-user-from-group
+func (vi VoiceIt2) RemoveUserFromGroup(groupId, userId string, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	if err := writer.WriteField("groupId", groupId); err != nil {
+		return []byte{}, errors.New("RemoveUserFromGroup Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("userId", userId); err != nil {
+		return []byte{}, errors.New("RemoveUserFromGroup Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("PUT", vi.BaseUrl+"/groups/removeUser"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("RemoveUserFromGroup Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "RemoveUserFromGroup", req, options)
+}
+
+// DeleteGroup takes the groupId generated during a createGroup and deletes
+// the group profile disassociates all users associated with it
+// For more details see https://api.voiceit.io/#delete-a-specific-group
+func (vi VoiceIt2) DeleteGroup(groupId string, opts ...CallOption) ([]byte, error) {
+	if err := GroupID(groupId).Validate(); err != nil {
+		return []byte{}, fmt.Errorf("DeleteGroup Exception: %w", err)
+	}
+
+	options := newCallOptions(opts)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	writer.Close()
+
+	req, err := http.NewRequest("DELETE", vi.BaseUrl+"/groups/"+url.PathEscape(groupId)+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("DeleteGroup Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "DeleteGroup", req, options)
+}
+
+// GetAllVoiceEnrollments takes the userId generated during a createUser
+// and returns a list of all voice enrollments for the user
+// For more details see https://api.voiceit.io/#get-voice-enrollments
+func (vi VoiceIt2) GetAllVoiceEnrollments(userId string, opts ...CallOption) ([]byte, error) {
+	if err := UserID(userId).Validate(); err != nil {
+		return []byte{}, fmt.Errorf("GetAllVoiceEnrollments Exception: %w", err)
+	}
+
+	options := newCallOptions(opts)
+
+	req, err := http.NewRequest("GET", vi.BaseUrl+"/enrollments/voice/"+url.PathEscape(userId)+vi.query(options, nil), nil)
+	if err != nil {
+		return []byte{}, errors.New("GetAllVoiceEnrollments Exception: " + err.Error())
+	}
+
+	return vi.do(vi.context(), "GetAllVoiceEnrollments", req, options)
+}
+
+// GetAllVideoEnrollments takes the userId generated during a createUser
+// and returns a list of all video enrollments for the user
+// For more details see https://api.voiceit.io/#get-video-enrollments
+func (vi VoiceIt2) GetAllVideoEnrollments(userId string, opts ...CallOption) ([]byte, error) {
+	if err := UserID(userId).Validate(); err != nil {
+		return []byte{}, fmt.Errorf("GetAllVideoEnrollments Exception: %w", err)
+	}
+
+	options := newCallOptions(opts)
+
+	req, err := http.NewRequest("GET", vi.BaseUrl+"/enrollments/video/"+url.PathEscape(userId)+vi.query(options, nil), nil)
+	if err != nil {
+		return []byte{}, errors.New("GetAllVideoEnrollments Exception: " + err.Error())
+	}
+
+	return vi.do(vi.context(), "GetAllVideoEnrollments", req, options)
+}
+
+// GetAllFaceEnrollments takes the userId generated during a createUser
+// and returns a list of all face enrollments for the user
+// For more details see https://api.voiceit.io/#get-face-enrollments
+func (vi VoiceIt2) GetAllFaceEnrollments(userId string, opts ...CallOption) ([]byte, error) {
+	if err := UserID(userId).Validate(); err != nil {
+		return []byte{}, fmt.Errorf("GetAllFaceEnrollments Exception: %w", err)
+	}
+
+	options := newCallOptions(opts)
+
+	req, err := http.NewRequest("GET", vi.BaseUrl+"/enrollments/face/"+url.PathEscape(userId)+vi.query(options, nil), nil)
+	if err != nil {
+		return []byte{}, errors.New("GetAllFaceEnrollments Exception: " + err.Error())
+	}
+
+	return vi.do(vi.context(), "GetAllFaceEnrollments", req, options)
+}
+
+// CreateVoiceEnrollment takes the userId generated during a createUser,
+// the contentLanguage(https://api.voiceit.io/#content-languages) for the phrase,
+// the text of a valid phrase for the developer account,
+// and absolute file path for an audio recording to create a voice enrollment for the user
+// For more details see https://api.voiceit.io/#create-voice-enrollment
+func (vi VoiceIt2) CreateVoiceEnrollment(userId, contentLanguage, phrase, filePath string, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	fileContents, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return []byte{}, errors.New("CreateVoiceEnrollment Exception: " + err.Error())
+	}
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	part, err := writer.CreateFormFile("recording", path.Base(filePath))
+	if err != nil {
+		return []byte{}, errors.New("CreateVoiceEnrollment Exception: " + err.Error())
+	}
+
+	if _, err := part.Write(fileContents); err != nil {
+		return []byte{}, errors.New("CreateVoiceEnrollment Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("userId", userId); err != nil {
+		return []byte{}, errors.New("CreateVoiceEnrollment Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("contentLanguage", contentLanguage); err != nil {
+		return []byte{}, errors.New("CreateVoiceEnrollment Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("phrase", phrase); err != nil {
+		return []byte{}, errors.New("CreateVoiceEnrollment Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/enrollments/voice"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("CreateVoiceEnrollment Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "CreateVoiceEnrollment", req, options)
+}
+
+// CreateVoiceEnrollmentByByteSlice takes the userId generated during a createUser,
+// the contentLanguage(https://api.voiceit.io/#content-languages) for the phrase,
+// the text of a valid phrase for the developer account,
+// file name for an audio recording to create a voice enrollment for the user
+// file data in []byte form for an audio recording to create a voice enrollment for the user
+func (vi VoiceIt2) CreateVoiceEnrollmentByByteSlice(userId, contentLanguage, phrase, filename string, fileData []byte, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	part, err := writer.CreateFormFile("recording", filename)
+	if err != nil {
+		return []byte{}, errors.New("CreateVoiceEnrollmentByByteSlice Exception: " + err.Error())
+	}
+
+	if _, err := part.Write(fileData); err != nil {
+		return []byte{}, errors.New("CreateVoiceEnrollmentByByteSlice Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("userId", userId); err != nil {
+		return []byte{}, errors.New("CreateVoiceEnrollmentByByteSlice Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("contentLanguage", contentLanguage); err != nil {
+		return []byte{}, errors.New("CreateVoiceEnrollmentByByteSlice Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("phrase", phrase); err != nil {
+		return []byte{}, errors.New("CreateVoiceEnrollmentByByteSlice Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/enrollments/voice"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("CreateVoiceEnrollmentByByteSlice Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "CreateVoiceEnrollmentByByteSlice", req, options)
+}
+
+// CreateVoiceEnrollmentByUrl takes the userId generated during a createUser,
+// the contentLanguage(https://api.voiceit.io/#content-languages) for the phrase,
+// the text of a valid phrase for the developer account,
+// and a fully qualified URL to an audio recording to create a voice enrollment for the user
+// For more details see https://api.voiceit.io/#create-voice-enrollment-by-url
+func (vi VoiceIt2) CreateVoiceEnrollmentByUrl(userId, contentLanguage, phrase, fileUrl string, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	if err := writer.WriteField("userId", userId); err != nil {
+		return []byte{}, errors.New("CreateVoiceEnrollmentByUrl Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("contentLanguage", contentLanguage); err != nil {
+		return []byte{}, errors.New("CreateVoiceEnrollmentByUrl Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("phrase", phrase); err != nil {
+		return []byte{}, errors.New("CreateVoiceEnrollmentByUrl Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("fileUrl", fileUrl); err != nil {
+		return []byte{}, errors.New("CreateVoiceEnrollmentByUrl Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/enrollments/voice/byUrl"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("CreateVoiceEnrollmentByUrl Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "CreateVoiceEnrollmentByUrl", req, options)
+}
+
+// CreateFaceEnrollment takes the userId generated during a createUser and
+// absolute file path for a video recording to create a face enrollment for the user
+func (vi VoiceIt2) CreateFaceEnrollment(userId, filePath string, isPhoto ...bool) ([]byte, error) {
+	return vi.CreateFaceEnrollmentWithOptions(userId, filePath, AsPhoto(len(isPhoto) > 0 && isPhoto[0]))
+}
+
+// CreateFaceEnrollmentWithOptions is CreateFaceEnrollment taking CallOptions, with AsPhoto in place of
+// isPhoto
+func (vi VoiceIt2) CreateFaceEnrollmentWithOptions(userId, filePath string, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	fileContents, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return []byte{}, errors.New("CreateFaceEnrollment Exception: " + err.Error())
+	}
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	fileFieldKey := "video"
+	if options.photo {
+		fileFieldKey = "photo"
+	}
+
+	part, err := writer.CreateFormFile(fileFieldKey, path.Base(filePath))
+	if err != nil {
+		return []byte{}, errors.New("CreateFaceEnrollment Exception: " + err.Error())
+	}
+
+	if _, err := part.Write(fileContents); err != nil {
+		return []byte{}, errors.New("CreateFaceEnrollment Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("userId", userId); err != nil {
+		return []byte{}, errors.New("CreateFaceEnrollment Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/enrollments/face"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("CreateFaceEnrollment Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "CreateFaceEnrollment", req, options)
+}
+
+// CreateFaceEnrollmentByByteSlice takes the userId generated during a CreateUser and
+// filename for a video recording to create a face enrollment for the user
+// fileData in []byte form for a video recording to create a face enrollment for the user
+func (vi VoiceIt2) CreateFaceEnrollmentByByteSlice(userId, filename string, fileData []byte, isPhoto ...bool) ([]byte, error) {
+	return vi.CreateFaceEnrollmentByByteSliceWithOptions(userId, filename, fileData, AsPhoto(len(isPhoto) > 0 && isPhoto[0]))
+}
+
+// CreateFaceEnrollmentByByteSliceWithOptions is CreateFaceEnrollmentByByteSlice taking CallOptions, with AsPhoto in place of
+// isPhoto
+func (vi VoiceIt2) CreateFaceEnrollmentByByteSliceWithOptions(userId, filename string, fileData []byte, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	fileFieldKey := "video"
+	if options.photo {
+		fileFieldKey = "photo"
+	}
+
+	part, err := writer.CreateFormFile(fileFieldKey, filename)
+	if err != nil {
+		return []byte{}, errors.New("CreateFaceEnrollmentByByteSlice Exception: " + err.Error())
+	}
+
+	if _, err := part.Write(fileData); err != nil {
+		return []byte{}, errors.New("CreateFaceEnrollmentByByteSlice Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("userId", userId); err != nil {
+		return []byte{}, errors.New("CreateFaceEnrollmentByByteSlice Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/enrollments/face"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("CreateFaceEnrollmentByByteSlice Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "CreateFaceEnrollmentByByteSlice", req, options)
+}
+
+// CreateFaceEnrollmentByUrl takes the userId generated during a createUser
+// and a fully qualified URL to a video recording to verify the user's face
+// For more details see https://api.voiceit.io/#create-face-enrollment-by-url
+func (vi VoiceIt2) CreateFaceEnrollmentByUrl(userId, fileUrl string, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	if err := writer.WriteField("userId", userId); err != nil {
+		return []byte{}, errors.New("CreateFaceEnrollmentByUrl Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("fileUrl", fileUrl); err != nil {
+		return []byte{}, errors.New("CreateFaceEnrollmentByUrl Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/enrollments/face/byUrl"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("CreateFaceEnrollmentByUrl Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "CreateFaceEnrollmentByUrl", req, options)
+}
+
+// CreateVideoEnrollment takes the userId generated during a createUser,
+// the contentLanguage(https://api.voiceit.io/#content-languages) for the phrase,
+// the text of a valid phrase for the developer account,
+// and absolute file path for a video recording to create a video enrollment for the user
+// For more details see https://api.voiceit.io/#create-video-enrollment
+func (vi VoiceIt2) CreateVideoEnrollment(userId, contentLanguage, phrase, filePath string, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	fileContents, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return []byte{}, errors.New("CreateVideoEnrollment Exception: " + err.Error())
+	}
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	part, err := writer.CreateFormFile("video", path.Base(filePath))
+	if err != nil {
+		return []byte{}, errors.New("CreateVideoEnrollment Exception: " + err.Error())
+	}
+
+	if _, err := part.Write(fileContents); err != nil {
+		return []byte{}, errors.New("CreateVideoEnrollment Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("userId", userId); err != nil {
+		return []byte{}, errors.New("CreateVideoEnrollment Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("contentLanguage", contentLanguage); err != nil {
+		return []byte{}, errors.New("CreateVideoEnrollment Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("phrase", phrase); err != nil {
+		return []byte{}, errors.New("CreateVideoEnrollment Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/enrollments/video"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("CreateVideoEnrollment Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "CreateVideoEnrollment", req, options)
+}
+
+// CreateVideoEnrollmentByByteSlice takes the userId generated during a createUser,
+// the contentLanguage(https://api.voiceit.io/#content-languages) for the phrase,
+// the text of a valid phrase for the developer account,
+// filename for a video recording to create a video enrollment for the user
+// and file data in []byte form for a video recording to create a video enrollment for the user
+func (vi VoiceIt2) CreateVideoEnrollmentByByteSlice(userId, contentLanguage, phrase, filename string, fileData []byte, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	part, err := writer.CreateFormFile("video", filename)
+	if err != nil {
+		return []byte{}, errors.New("CreateVideoEnrollmentByByteSlice Exception: " + err.Error())
+	}
+
+	if _, err := part.Write(fileData); err != nil {
+		return []byte{}, errors.New("CreateVideoEnrollmentByByteSlice Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("userId", userId); err != nil {
+		return []byte{}, errors.New("CreateVideoEnrollmentByByteSlice Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("contentLanguage", contentLanguage); err != nil {
+		return []byte{}, errors.New("CreateVideoEnrollmentByByteSlice Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("phrase", phrase); err != nil {
+		return []byte{}, errors.New("CreateVideoEnrollmentByByteSlice Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/enrollments/video"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("CreateVideoEnrollmentByByteSlice Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "CreateVideoEnrollmentByByteSlice", req, options)
+}
+
+// CreateSplitVideoEnrollment takes the userId generated during a createUser,
+// the contentLanguage(https://api.voiceit.io/#content-languages) for the phrase,
+// the text of a valid phrase for the developer account,
+// and absolute file paths for a photo and audio recording
+// Written for VoiceIt internal projects
+func (vi VoiceIt2) CreateSplitVideoEnrollment(userId, contentLanguage, phrase, audioFilePath, photoFilePath string, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	audioFileContents, err := ioutil.ReadFile(audioFilePath)
+	if err != nil {
+		return []byte{}, errors.New("CreateSplitVideoEnrollment Exception: " + err.Error())
+	}
+
+	photoFileContents, err := ioutil.ReadFile(photoFilePath)
+	if err != nil {
+		return []byte{}, errors.New("CreateSplitVideoEnrollment Exception: " + err.Error())
+	}
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	audioPart, err := writer.CreateFormFile("audio", path.Base(audioFilePath))
+	if err != nil {
+		return []byte{}, errors.New("CreateSplitVideoEnrollment Exception: " + err.Error())
+	}
+
+	if _, err := audioPart.Write(audioFileContents); err != nil {
+		return []byte{}, errors.New("CreateSplitVideoEnrollment Exception: " + err.Error())
+	}
+
+	photoPart, err := writer.CreateFormFile("photo", path.Base(photoFilePath))
+	if err != nil {
+		return []byte{}, errors.New("CreateSplitVideoEnrollment Exception: " + err.Error())
+	}
+
+	if _, err := photoPart.Write(photoFileContents); err != nil {
+		return []byte{}, errors.New("CreateSplitVideoEnrollment Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("userId", userId); err != nil {
+		return []byte{}, errors.New("CreateSplitVideoEnrollment Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("contentLanguage", contentLanguage); err != nil {
+		return []byte{}, errors.New("CreateSplitVideoEnrollment Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("phrase", phrase); err != nil {
+		return []byte{}, errors.New("CreateSplitVideoEnrollment Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/enrollments/video"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("CreateSplitVideoEnrollment Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "CreateSplitVideoEnrollment", req, options)
+}
+
+// CreateSplitVideoEnrollmentByByteSlice takes the userId generated during a createUser,
+// the contentLanguage(https://api.voiceit.io/#content-languages) for the phrase,
+// the text of a valid phrase for the developer account,
+// filename for a photo and audio recording
+// and file data in []byte form for a photo and audio recording
+// Written for VoiceIt internal projects
+func (vi VoiceIt2) CreateSplitVideoEnrollmentByByteSlice(userId, contentLanguage, phrase, audioFilename, photoFilename string, audioFileData, photoFileData []byte, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	audioPart, err := writer.CreateFormFile("audio", audioFilename)
+	if err != nil {
+		return []byte{}, errors.New("CreateSplitVideoEnrollmentByByteSlice Exception: " + err.Error())
+	}
+
+	if _, err := audioPart.Write(audioFileData); err != nil {
+		return []byte{}, errors.New("CreateSplitVideoEnrollmentByByteSlice Exception: " + err.Error())
+	}
+
+	photoPart, err := writer.CreateFormFile("photo", photoFilename)
+	if err != nil {
+		return []byte{}, errors.New("CreateSplitVideoEnrollmentByByteSlice Exception: " + err.Error())
+	}
+
+	if _, err := photoPart.Write(photoFileData); err != nil {
+		return []byte{}, errors.New("CreateSplitVideoEnrollmentByByteSlice Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("userId", userId); err != nil {
+		return []byte{}, errors.New("CreateSplitVideoEnrollmentByByteSlice Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("contentLanguage", contentLanguage); err != nil {
+		return []byte{}, errors.New("CreateSplitVideoEnrollmentByByteSlice Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("phrase", phrase); err != nil {
+		return []byte{}, errors.New("CreateSplitVideoEnrollmentByByteSlice Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/enrollments/video"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("CreateSplitVideoEnrollmentByByteSlice Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "CreateSplitVideoEnrollmentByByteSlice", req, options)
+}
+
+// CreateVideoEnrollmentByUrl takes the userId generated during a createUser,
+// the contentLanguage(https://api.voiceit.io/#content-languages) for the phrase,
+// the text of a valid phrase for the developer account,
+// and a fully qualified URL to a video recording to create a video enrollment for the user
+// For more details see https://api.voiceit.io/#create-video-enrollment-by-url
+func (vi VoiceIt2) CreateVideoEnrollmentByUrl(userId, contentLanguage, phrase, fileUrl string, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	if err := writer.WriteField("userId", userId); err != nil {
+		return []byte{}, errors.New("CreateVideoEnrollmentByUrl Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("contentLanguage", contentLanguage); err != nil {
+		return []byte{}, errors.New("CreateVideoEnrollmentByUrl Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("phrase", phrase); err != nil {
+		return []byte{}, errors.New("CreateVideoEnrollmentByUrl Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("fileUrl", fileUrl); err != nil {
+		return []byte{}, errors.New("CreateVideoEnrollmentByUrl Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/enrollments/video/byUrl"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("CreateVideoEnrollmentByUrl Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "CreateVideoEnrollmentByUrl", req, options)
+}
+
+// DeleteAllEnrollments takes the userId generated during a createUser
+// and deletes all video/voice enrollments for the user
+// For more details see https://api.voiceit.io/#delete-all-enrollments-for-user
+func (vi VoiceIt2) DeleteAllEnrollments(userId string, opts ...CallOption) ([]byte, error) {
+	if err := UserID(userId).Validate(); err != nil {
+		return []byte{}, fmt.Errorf("DeleteAllEnrollments Exception: %w", err)
+	}
+
+	options := newCallOptions(opts)
+
+	req, err := http.NewRequest("DELETE", vi.BaseUrl+"/enrollments/"+url.PathEscape(userId)+"/all"+vi.query(options, nil), nil)
+	if err != nil {
+		return []byte{}, errors.New("DeleteAllEnrollments Exception: " + err.Error())
+	}
+
+	return vi.do(vi.context(), "DeleteAllEnrollments", req, options)
+}
+
+// DeleteVoiceEnrollment takes the userId generated during a createUser and the
+// voiceEnrollmentId returned when the enrollment was created, and deletes that voice enrollment
+// For more details see https://api.voiceit.io/#delete-voice-enrollment
+func (vi VoiceIt2) DeleteVoiceEnrollment(userId string, voiceEnrollmentId int, opts ...CallOption) ([]byte, error) {
+	if err := UserID(userId).Validate(); err != nil {
+		return []byte{}, fmt.Errorf("DeleteVoiceEnrollment Exception: %w", err)
+	}
+
+	options := newCallOptions(opts)
+
+	req, err := http.NewRequest("DELETE", vi.BaseUrl+"/enrollments/voice/"+url.PathEscape(userId)+"/"+strconv.Itoa(voiceEnrollmentId)+vi.query(options, nil), nil)
+	if err != nil {
+		return []byte{}, errors.New("DeleteVoiceEnrollment Exception: " + err.Error())
+	}
+
+	return vi.do(vi.context(), "DeleteVoiceEnrollment", req, options)
+}
+
+// DeleteFaceEnrollment takes the userId generated during a createUser and the
+// faceEnrollmentId returned when the enrollment was created, and deletes that face enrollment
+// For more details see https://api.voiceit.io/#delete-face-enrollment
+func (vi VoiceIt2) DeleteFaceEnrollment(userId string, faceEnrollmentId int, opts ...CallOption) ([]byte, error) {
+	if err := UserID(userId).Validate(); err != nil {
+		return []byte{}, fmt.Errorf("DeleteFaceEnrollment Exception: %w", err)
+	}
+
+	options := newCallOptions(opts)
+
+	req, err := http.NewRequest("DELETE", vi.BaseUrl+"/enrollments/face/"+url.PathEscape(userId)+"/"+strconv.Itoa(faceEnrollmentId)+vi.query(options, nil), nil)
+	if err != nil {
+		return []byte{}, errors.New("DeleteFaceEnrollment Exception: " + err.Error())
+	}
+
+	return vi.do(vi.context(), "DeleteFaceEnrollment", req, options)
+}
+
+// DeleteVideoEnrollment takes the userId generated during a createUser and the
+// videoEnrollmentId returned when the enrollment was created, and deletes that video enrollment
+// For more details see https://api.voiceit.io/#delete-video-enrollment
+func (vi VoiceIt2) DeleteVideoEnrollment(userId string, videoEnrollmentId int, opts ...CallOption) ([]byte, error) {
+	if err := UserID(userId).Validate(); err != nil {
+		return []byte{}, fmt.Errorf("DeleteVideoEnrollment Exception: %w", err)
+	}
+
+	options := newCallOptions(opts)
+
+	req, err := http.NewRequest("DELETE", vi.BaseUrl+"/enrollments/video/"+url.PathEscape(userId)+"/"+strconv.Itoa(videoEnrollmentId)+vi.query(options, nil), nil)
+	if err != nil {
+		return []byte{}, errors.New("DeleteVideoEnrollment Exception: " + err.Error())
+	}
+
+	return vi.do(vi.context(), "DeleteVideoEnrollment", req, options)
+}
+
+// VoiceVerification takes the userId generated during a createUser,
+// the contentLanguage(https://api.voiceit.io/#content-languages) for the phrase,
+// the text of a valid phrase for the developer account,
+// and absolute file path for an audio recording to verify the user's voice
+// For more details see https://api.voiceit.io/#verify-a-user-s-voice
+func (vi VoiceIt2) VoiceVerification(userId, contentLanguage, phrase, filePath string, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	fileContents, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return []byte{}, errors.New("VoiceVerification Exception: " + err.Error())
+	}
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	part, err := writer.CreateFormFile("recording", path.Base(filePath))
+	if err != nil {
+		return []byte{}, errors.New("VoiceVerification Exception: " + err.Error())
+	}
+
+	if _, err := part.Write(fileContents); err != nil {
+		return []byte{}, errors.New("VoiceVerification Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("userId", userId); err != nil {
+		return []byte{}, errors.New("VoiceVerification Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("contentLanguage", contentLanguage); err != nil {
+		return []byte{}, errors.New("VoiceVerification Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("phrase", phrase); err != nil {
+		return []byte{}, errors.New("VoiceVerification Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/verification/voice"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("VoiceVerification Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "VoiceVerification", req, options)
+}
+
+// VoiceVerificationByByteSlice takes the userId generated during a createUser,
+// the contentLanguage(https://api.voiceit.io/#content-languages) for the phrase,
+// the text of a valid phrase for the developer account,
+// filename for an audio recording to verify the user's voice
+// and file data in []byte form for an audio recording to verify the user's voice
+func (vi VoiceIt2) VoiceVerificationByByteSlice(userId, contentLanguage, phrase, filename string, fileData []byte, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	part, err := writer.CreateFormFile("recording", filename)
+	if err != nil {
+		return []byte{}, errors.New("VoiceVerificationByByteSlice Exception: " + err.Error())
+	}
+
+	if _, err := part.Write(fileData); err != nil {
+		return []byte{}, errors.New("VoiceVerificationByByteSlice Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("userId", userId); err != nil {
+		return []byte{}, errors.New("VoiceVerificationByByteSlice Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("contentLanguage", contentLanguage); err != nil {
+		return []byte{}, errors.New("VoiceVerificationByByteSlice Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("phrase", phrase); err != nil {
+		return []byte{}, errors.New("VoiceVerificationByByteSlice Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/verification/voice"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("VoiceVerificationByByteSlice Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "VoiceVerificationByByteSlice", req, options)
+}
+
+// VoiceVerificationByUrl takes the userId generated during a createUser,
+// the contentLanguage(https://api.voiceit.io/#content-languages) for the phrase,
+// the text of a valid phrase for the developer account,
+// and a fully qualified URL to an audio recording to verify the user's voice
+// For more details see https://api.voiceit.io/#verify-a-user-s-voice-by-url
+func (vi VoiceIt2) VoiceVerificationByUrl(userId, contentLanguage, phrase, fileUrl string, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	if err := writer.WriteField("userId", userId); err != nil {
+		return []byte{}, errors.New("VoiceVerificationByUrl Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("contentLanguage", contentLanguage); err != nil {
+		return []byte{}, errors.New("VoiceVerificationByUrl Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("phrase", phrase); err != nil {
+		return []byte{}, errors.New("VoiceVerificationByUrl Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("fileUrl", fileUrl); err != nil {
+		return []byte{}, errors.New("VoiceVerificationByUrl Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/verification/voice/byUrl"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("VoiceVerificationByUrl Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "VoiceVerificationByUrl", req, options)
+}
+
+// FaceVerification takes the userId generated during a createUser and a
+// absolute file path for a video recording to verify the user's face
+// For more details see https://api.voiceit.io/#verify-a-user-s-face
+func (vi VoiceIt2) FaceVerification(userId, filePath string, isPhoto ...bool) ([]byte, error) {
+	return vi.FaceVerificationWithOptions(userId, filePath, AsPhoto(len(isPhoto) > 0 && isPhoto[0]))
+}
+
+// FaceVerificationWithOptions is FaceVerification taking CallOptions, with AsPhoto in place of
+// isPhoto
+func (vi VoiceIt2) FaceVerificationWithOptions(userId, filePath string, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	fileContents, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return []byte{}, errors.New("FaceVerification Exception: " + err.Error())
+	}
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	fileFieldKey := "video"
+	if options.photo {
+		fileFieldKey = "photo"
+	}
+
+	part, err := writer.CreateFormFile(fileFieldKey, path.Base(filePath))
+	if err != nil {
+		return []byte{}, errors.New("FaceVerification Exception: " + err.Error())
+	}
+
+	if _, err := part.Write(fileContents); err != nil {
+		return []byte{}, errors.New("FaceVerification Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("userId", userId); err != nil {
+		return []byte{}, errors.New("FaceVerification Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/verification/face"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("FaceVerification Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "FaceVerification", req, options)
+}
+
+// FaceVerificationByByteSlice takes the userId generated during a createUser and a
+// filename for a video recording to verify the user's face
+// and file data in []byte form for a video recording to verify the user's face
+func (vi VoiceIt2) FaceVerificationByByteSlice(userId, filename string, fileData []byte, isPhoto ...bool) ([]byte, error) {
+	return vi.FaceVerificationByByteSliceWithOptions(userId, filename, fileData, AsPhoto(len(isPhoto) > 0 && isPhoto[0]))
+}
+
+// FaceVerificationByByteSliceWithOptions is FaceVerificationByByteSlice taking CallOptions, with AsPhoto in place of
+// isPhoto
+func (vi VoiceIt2) FaceVerificationByByteSliceWithOptions(userId, filename string, fileData []byte, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	fileFieldKey := "video"
+	if options.photo {
+		fileFieldKey = "photo"
+	}
+
+	part, err := writer.CreateFormFile(fileFieldKey, filename)
+	if err != nil {
+		return []byte{}, errors.New("FaceVerificationByByteSlice Exception: " + err.Error())
+	}
+
+	if _, err := part.Write(fileData); err != nil {
+		return []byte{}, errors.New("FaceVerificationByByteSlice Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("userId", userId); err != nil {
+		return []byte{}, errors.New("FaceVerificationByByteSlice Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/verification/face"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("FaceVerificationByByteSlice Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "FaceVerificationByByteSlice", req, options)
+}
+
+// FaceVerificationByUrl takes the userId generated during a createUser
+// and a fully qualified URL to a video recording to verify the user's face
+// For more details see https://api.voiceit.io/#verify-a-user-s-face-by-url
+func (vi VoiceIt2) FaceVerificationByUrl(userId, fileUrl string, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	if err := writer.WriteField("userId", userId); err != nil {
+		return []byte{}, errors.New("FaceVerificationByUrl Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("fileUrl", fileUrl); err != nil {
+		return []byte{}, errors.New("FaceVerificationByUrl Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/verification/face/byUrl"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("FaceVerificationByUrl Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "FaceVerificationByUrl", req, options)
+}
+
+// VideoVerification takes the userId generated during a createUser,
+// the contentLanguage(https://api.voiceit.io/#content-languages) for the phrase,
+// the text of a valid phrase for the developer account,
+// and absolute file path for a video recording to verify the user's face and voice
+// For more details see https://api.voiceit.io/#video-verification
+func (vi VoiceIt2) VideoVerification(userId, contentLanguage, phrase, filePath string, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	fileContents, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return []byte{}, errors.New("VideoVerification Exception: " + err.Error())
+	}
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	part, err := writer.CreateFormFile("video", path.Base(filePath))
+	if err != nil {
+		return []byte{}, errors.New("VideoVerification Exception: " + err.Error())
+	}
+
+	if _, err := part.Write(fileContents); err != nil {
+		return []byte{}, errors.New("VideoVerification Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("userId", userId); err != nil {
+		return []byte{}, errors.New("VideoVerification Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("contentLanguage", contentLanguage); err != nil {
+		return []byte{}, errors.New("VideoVerification Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("phrase", phrase); err != nil {
+		return []byte{}, errors.New("VideoVerification Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/verification/video"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("VideoVerification Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "VideoVerification", req, options)
+}
+
+// VideoVerificationByByteSlice takes the userId generated during a createUser,
+// the contentLanguage(https://api.voiceit.io/#content-languages) for the phrase,
+// the text of a valid phrase for the developer account,
+// and filename for a video recording to verify the user's face and voice
+// and file data in []byte form for a video recording to verify the user's face and voice
+func (vi VoiceIt2) VideoVerificationByByteSlice(userId, contentLanguage, phrase, filename string, fileData []byte, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	part, err := writer.CreateFormFile("video", filename)
+	if err != nil {
+		return []byte{}, errors.New("VideoVerificationByByteSlice Exception: " + err.Error())
+	}
+
+	if _, err := part.Write(fileData); err != nil {
+		return []byte{}, errors.New("VideoVerificationByByteSlice Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("userId", userId); err != nil {
+		return []byte{}, errors.New("VideoVerificationByByteSlice Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("contentLanguage", contentLanguage); err != nil {
+		return []byte{}, errors.New("VideoVerificationByByteSlice Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("phrase", phrase); err != nil {
+		return []byte{}, errors.New("VideoVerificationByByteSlice Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/verification/video"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("VideoVerificationByByteSlice Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "VideoVerificationByByteSlice", req, options)
+}
+
+// SplitVideoVerification takes the userId generated during a createUser,
+// the contentLanguage(https://api.voiceit.io/#content-languages) for the phrase,
+// the text of a valid phrase for the developer account,
+// and absolute file paths for a photo and audio recording to verify the user's face and voice
+// Written for VoiceIt internal projects
+func (vi VoiceIt2) SplitVideoVerification(userId, contentLanguage, phrase, audioFilePath, photoFilePath string, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	audioFileContents, err := ioutil.ReadFile(audioFilePath)
+	if err != nil {
+		return []byte{}, errors.New("SplitVideoVerification Exception: " + err.Error())
+	}
+
+	photoFileContents, err := ioutil.ReadFile(photoFilePath)
+	if err != nil {
+		return []byte{}, errors.New("SplitVideoVerification Exception: " + err.Error())
+	}
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	audioPart, err := writer.CreateFormFile("audio", path.Base(audioFilePath))
+	if err != nil {
+		return []byte{}, errors.New("SplitVideoVerification Exception: " + err.Error())
+	}
+
+	if _, err := audioPart.Write(audioFileContents); err != nil {
+		return []byte{}, errors.New("SplitVideoVerification Exception: " + err.Error())
+	}
+
+	photoPart, err := writer.CreateFormFile("photo", path.Base(photoFilePath))
+	if err != nil {
+		return []byte{}, errors.New("SplitVideoVerification Exception: " + err.Error())
+	}
+
+	if _, err := photoPart.Write(photoFileContents); err != nil {
+		return []byte{}, errors.New("SplitVideoVerification Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("userId", userId); err != nil {
+		return []byte{}, errors.New("SplitVideoVerification Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("contentLanguage", contentLanguage); err != nil {
+		return []byte{}, errors.New("SplitVideoVerification Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("phrase", phrase); err != nil {
+		return []byte{}, errors.New("SplitVideoVerification Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/verification/video"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("SplitVideoVerification Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "SplitVideoVerification", req, options)
+}
+
+// SplitVideoVerificationByByteSlice takes the userId generated during a createUser,
+// the contentLanguage(https://api.voiceit.io/#content-languages) for the phrase,
+// the text of a valid phrase for the developer account,
+// file names for a photo and audio recording to verify the user's face and voice
+// and file data in []byte form for a photo and audio recording to verify the user's face and voice
+func (vi VoiceIt2) SplitVideoVerificationByByteSlice(userId, contentLanguage, phrase, audioFilename, photoFilename string, audioFileData, photoFileData []byte, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	audioPart, err := writer.CreateFormFile("audio", audioFilename)
+	if err != nil {
+		return []byte{}, errors.New("SplitVideoVerificationByByteSlice Exception: " + err.Error())
+	}
+
+	if _, err := audioPart.Write(audioFileData); err != nil {
+		return []byte{}, errors.New("SplitVideoVerificationByByteSlice Exception: " + err.Error())
+	}
+
+	photoPart, err := writer.CreateFormFile("photo", photoFilename)
+	if err != nil {
+		return []byte{}, errors.New("SplitVideoVerificationByByteSlice Exception: " + err.Error())
+	}
+
+	if _, err := photoPart.Write(photoFileData); err != nil {
+		return []byte{}, errors.New("SplitVideoVerificationByByteSlice Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("userId", userId); err != nil {
+		return []byte{}, errors.New("SplitVideoVerificationByByteSlice Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("contentLanguage", contentLanguage); err != nil {
+		return []byte{}, errors.New("SplitVideoVerificationByByteSlice Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("phrase", phrase); err != nil {
+		return []byte{}, errors.New("SplitVideoVerificationByByteSlice Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/verification/video"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("SplitVideoVerificationByByteSlice Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "SplitVideoVerificationByByteSlice", req, options)
+}
+
+// VideoVerificationByUrl takes the userId generated during a createUser,
+// the contentLanguage(https://api.voiceit.io/#content-languages) for the phrase,
+// the text of a valid phrase for the developer account,
+// and a fully qualified URL to a video recording to verify the user's face and voice
+// For more details see https://api.voiceit.io/#video-verification-by-url
+func (vi VoiceIt2) VideoVerificationByUrl(userId, contentLanguage, phrase, fileUrl string, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	if err := writer.WriteField("userId", userId); err != nil {
+		return []byte{}, errors.New("VideoVerificationByUrl Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("contentLanguage", contentLanguage); err != nil {
+		return []byte{}, errors.New("VideoVerificationByUrl Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("phrase", phrase); err != nil {
+		return []byte{}, errors.New("VideoVerificationByUrl Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("fileUrl", fileUrl); err != nil {
+		return []byte{}, errors.New("VideoVerificationByUrl Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/verification/video/byUrl"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("VideoVerificationByUrl Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "VideoVerificationByUrl", req, options)
+}
+
+// VoiceIdentification takes the groupId generated during a createGroup,
+// the contentLanguage(https://api.voiceit.io/#content-languages) for the phrase,
+// the text of a valid phrase for the developer account,
+// and absolute file path for an audio recording to idetify the user's voice
+// amongst others in the group
+// For more details see https://api.voiceit.io/#identify-a-user-s-voice
+func (vi VoiceIt2) VoiceIdentification(groupId, contentLanguage, phrase, filePath string, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	fileContents, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return []byte{}, errors.New("VoiceIdentification Exception: " + err.Error())
+	}
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	part, err := writer.CreateFormFile("recording", path.Base(filePath))
+	if err != nil {
+		return []byte{}, errors.New("VoiceIdentification Exception: " + err.Error())
+	}
+
+	if _, err := part.Write(fileContents); err != nil {
+		return []byte{}, errors.New("VoiceIdentification Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("groupId", groupId); err != nil {
+		return []byte{}, errors.New("VoiceIdentification Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("contentLanguage", contentLanguage); err != nil {
+		return []byte{}, errors.New("VoiceIdentification Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("phrase", phrase); err != nil {
+		return []byte{}, errors.New("VoiceIdentification Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/identification/voice"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("VoiceIdentification Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "VoiceIdentification", req, options)
+}
+
+// VoiceIdentificationByByteSlice takes the groupId generated during a createGroup,
+// the contentLanguage(https://api.voiceit.io/#content-languages) for the phrase,
+// the text of a valid phrase for the developer account,
+// file name for an audio recording to idetify the user's voice
+// and file data in []byte form for an audio recording to idetify the user's voice
+// amongst others in the group
+// For more details see https://api.voiceit.io/#identify-a-user-s-voice
+func (vi VoiceIt2) VoiceIdentificationByByteSlice(groupId, contentLanguage, phrase, filename string, fileData []byte, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	part, err := writer.CreateFormFile("recording", filename)
+	if err != nil {
+		return []byte{}, errors.New("VoiceIdentificationByByteSlice Exception: " + err.Error())
+	}
+
+	if _, err := part.Write(fileData); err != nil {
+		return []byte{}, errors.New("VoiceIdentificationByByteSlice Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("groupId", groupId); err != nil {
+		return []byte{}, errors.New("VoiceIdentificationByByteSlice Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("contentLanguage", contentLanguage); err != nil {
+		return []byte{}, errors.New("VoiceIdentificationByByteSlice Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("phrase", phrase); err != nil {
+		return []byte{}, errors.New("VoiceIdentificationByByteSlice Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/identification/voice"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("VoiceIdentificationByByteSlice Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "VoiceIdentificationByByteSlice", req, options)
+}
+
+// VoiceIdentificationByUrl takes the groupId generated during a createGroup,
+// the contentLanguage(https://api.voiceit.io/#content-languages) for the phrase,
+// the text of a valid phrase for the developer account,
+// and a fully qualified URL to an audio recording to idetify the user's voice
+// amongst others in the group
+// For more details see https://api.voiceit.io/#identify-a-user-s-voice-by-url
+func (vi VoiceIt2) VoiceIdentificationByUrl(groupId, contentLanguage, phrase, fileUrl string, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	if err := writer.WriteField("groupId", groupId); err != nil {
+		return []byte{}, errors.New("VoiceIdentificationByUrl Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("contentLanguage", contentLanguage); err != nil {
+		return []byte{}, errors.New("VoiceIdentificationByUrl Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("phrase", phrase); err != nil {
+		return []byte{}, errors.New("VoiceIdentificationByUrl Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("fileUrl", fileUrl); err != nil {
+		return []byte{}, errors.New("VoiceIdentificationByUrl Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/identification/voice/byUrl"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("VoiceIdentificationByUrl Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "VoiceIdentificationByUrl", req, options)
+}
+
+// VideoIdentification takes the groupId generated during a createGroup,
+// the contentLanguage(https://api.voiceit.io/#content-languages) for the phrase,
+// the text of a valid phrase for the developer account,
+// and absolute file path for a video recording to idetify the user's face and voice
+// amongst others in the group
+// For more details see https://api.voiceit.io/#identify-a-user-s-voice-amp-face
+func (vi VoiceIt2) VideoIdentification(groupId, contentLanguage, phrase, filePath string, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	fileContents, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return []byte{}, errors.New("VideoIdentification Exception: " + err.Error())
+	}
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	part, err := writer.CreateFormFile("video", path.Base(filePath))
+	if err != nil {
+		return []byte{}, errors.New("VideoIdentification Exception: " + err.Error())
+	}
+
+	if _, err := part.Write(fileContents); err != nil {
+		return []byte{}, errors.New("VideoIdentification Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("groupId", groupId); err != nil {
+		return []byte{}, errors.New("VideoIdentification Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("contentLanguage", contentLanguage); err != nil {
+		return []byte{}, errors.New("VideoIdentification Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("phrase", phrase); err != nil {
+		return []byte{}, errors.New("VideoIdentification Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/identification/video"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("VideoIdentification Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "VideoIdentification", req, options)
+}
+
+// VideoIdentificationByByteSlice takes the groupId generated during a createGroup,
+// the contentLanguage(https://api.voiceit.io/#content-languages) for the phrase,
+// the text of a valid phrase for the developer account,
+// file name for a video recording to idetify the user's face and voice
+// and file data in []byte form for a video recording to idetify the user's face and voice
+// amongst others in the group
+func (vi VoiceIt2) VideoIdentificationByByteSlice(groupId, contentLanguage, phrase, filename string, fileData []byte, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	part, err := writer.CreateFormFile("video", filename)
+	if err != nil {
+		return []byte{}, errors.New("VideoIdentificationByByteSlice Exception: " + err.Error())
+	}
+
+	if _, err := part.Write(fileData); err != nil {
+		return []byte{}, errors.New("VideoIdentificationByByteSlice Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("groupId", groupId); err != nil {
+		return []byte{}, errors.New("VideoIdentificationByByteSlice Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("contentLanguage", contentLanguage); err != nil {
+		return []byte{}, errors.New("VideoIdentificationByByteSlice Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("phrase", phrase); err != nil {
+		return []byte{}, errors.New("VideoIdentificationByByteSlice Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/identification/video"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("VideoIdentificationByByteSlice Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "VideoIdentificationByByteSlice", req, options)
+}
+
+// SplitVideoIdentification takes the groupId generated during a createGroup,
+// the contentLanguage(https://api.voiceit.io/#content-languages) for the phrase,
+// the text of a valid phrase for the developer account,
+// and absolute file path for a video recording to idetify the user's face and voice
+// amongst others in the group
+// For more details see https://api.voiceit.io/#identify-a-user-s-voice-amp-face
+func (vi VoiceIt2) SplitVideoIdentification(groupId, contentLanguage, phrase, audioFilePath, photoFilePath string, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	audioFileContents, err := ioutil.ReadFile(audioFilePath)
+	if err != nil {
+		return []byte{}, errors.New("SplitVideoIdentification Exception: " + err.Error())
+	}
+
+	photoFileContents, err := ioutil.ReadFile(photoFilePath)
+	if err != nil {
+		return []byte{}, errors.New("SplitVideoIdentification Exception: " + err.Error())
+	}
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	audioPart, err := writer.CreateFormFile("audio", path.Base(audioFilePath))
+	if err != nil {
+		return []byte{}, errors.New("SplitVideoIdentification Exception: " + err.Error())
+	}
+
+	if _, err := audioPart.Write(audioFileContents); err != nil {
+		return []byte{}, errors.New("SplitVideoIdentification Exception: " + err.Error())
+	}
+
+	photoPart, err := writer.CreateFormFile("photo", path.Base(photoFilePath))
+	if err != nil {
+		return []byte{}, errors.New("SplitVideoIdentification Exception: " + err.Error())
+	}
+
+	if _, err := photoPart.Write(photoFileContents); err != nil {
+		return []byte{}, errors.New("SplitVideoIdentification Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("groupId", groupId); err != nil {
+		return []byte{}, errors.New("SplitVideoIdentification Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("contentLanguage", contentLanguage); err != nil {
+		return []byte{}, errors.New("SplitVideoIdentification Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("phrase", phrase); err != nil {
+		return []byte{}, errors.New("SplitVideoIdentification Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/identification/video"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("SplitVideoIdentification Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "SplitVideoIdentification", req, options)
+}
+
+// SplitVideoIdentificationByByteSlice takes the groupId generated during a createGroup,
+// the contentLanguage(https://api.voiceit.io/#content-languages) for the phrase,
+// the text of a valid phrase for the developer account,
+// file name for a video recording to idetify the user's face and voice
+// and file data in []byte form for a video recording to idetify the user's face and voice
+// amongst others in the group
+// For more details see https://api.voiceit.io/#identify-a-user-s-voice-amp-face
+func (vi VoiceIt2) SplitVideoIdentificationByByteSlice(groupId, contentLanguage, phrase, audioFilename, photoFilename string, audioFileData, photoFileData []byte, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	audioPart, err := writer.CreateFormFile("audio", audioFilename)
+	if err != nil {
+		return []byte{}, errors.New("SplitVideoIdentificationByByteSlice Exception: " + err.Error())
+	}
+
+	if _, err := audioPart.Write(audioFileData); err != nil {
+		return []byte{}, errors.New("SplitVideoIdentificationByByteSlice Exception: " + err.Error())
+	}
+
+	photoPart, err := writer.CreateFormFile("photo", photoFilename)
+	if err != nil {
+		return []byte{}, errors.New("SplitVideoIdentificationByByteSlice Exception: " + err.Error())
+	}
+
+	if _, err := photoPart.Write(photoFileData); err != nil {
+		return []byte{}, errors.New("SplitVideoIdentificationByByteSlice Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("groupId", groupId); err != nil {
+		return []byte{}, errors.New("SplitVideoIdentificationByByteSlice Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("contentLanguage", contentLanguage); err != nil {
+		return []byte{}, errors.New("SplitVideoIdentificationByByteSlice Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("phrase", phrase); err != nil {
+		return []byte{}, errors.New("SplitVideoIdentificationByByteSlice Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/identification/video"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("SplitVideoIdentificationByByteSlice Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "SplitVideoIdentificationByByteSlice", req, options)
+}
+
+// VideoIdentificationByUrl takes the groupId generated during a createGroup,
+// the contentLanguage(https://api.voiceit.io/#content-languages) for the phrase,
+// the text of a valid phrase for the developer account,
+// and a fully qualified URL to a video recording to idetify the user's face and voice
+// amongst others in the group
+// For more details see https://api.voiceit.io/#identify-a-user-s-voice-amp-face-by-url
+func (vi VoiceIt2) VideoIdentificationByUrl(groupId, contentLanguage, phrase, fileUrl string, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	if err := writer.WriteField("groupId", groupId); err != nil {
+		return []byte{}, errors.New("VideoIdentificationByUrl Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("contentLanguage", contentLanguage); err != nil {
+		return []byte{}, errors.New("VideoIdentificationByUrl Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("phrase", phrase); err != nil {
+		return []byte{}, errors.New("VideoIdentificationByUrl Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("fileUrl", fileUrl); err != nil {
+		return []byte{}, errors.New("VideoIdentificationByUrl Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/identification/video/byUrl"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("VideoIdentificationByUrl Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "VideoIdentificationByUrl", req, options)
+}
+
+// FaceIdentification takes the groupId generated during a createGroup,
+// and absolute file path for a face recording to idetify the user's face
+// amongst others in the group
+// For more details see https://api.voiceit.io/#identify-a-user-s-face
+func (vi VoiceIt2) FaceIdentification(groupId, filePath string, isPhoto ...bool) ([]byte, error) {
+	return vi.FaceIdentificationWithOptions(groupId, filePath, AsPhoto(len(isPhoto) > 0 && isPhoto[0]))
+}
+
+// FaceIdentificationWithOptions is FaceIdentification taking CallOptions, with AsPhoto in place of
+// isPhoto
+func (vi VoiceIt2) FaceIdentificationWithOptions(groupId, filePath string, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	fileContents, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return []byte{}, errors.New("FaceIdentification Exception: " + err.Error())
+	}
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	fileFieldKey := "video"
+	if options.photo {
+		fileFieldKey = "photo"
+	}
+
+	part, err := writer.CreateFormFile(fileFieldKey, path.Base(filePath))
+	if err != nil {
+		return []byte{}, errors.New("FaceIdentification Exception: " + err.Error())
+	}
+
+	if _, err := part.Write(fileContents); err != nil {
+		return []byte{}, errors.New("FaceIdentification Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("groupId", groupId); err != nil {
+		return []byte{}, errors.New("FaceIdentification Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/identification/face"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("FaceIdentification Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "FaceIdentification", req, options)
+}
+
+// FaceIdentificationByByteSlice takes the groupId generated during a createGroup,
+// file name for a face recording to idetify the user's face
+// and file data in []byte form for a face recording to idetify the user's face
+// amongst others in the group
+// For more details see https://api.voiceit.io/#identify-a-user-s-face
+func (vi VoiceIt2) FaceIdentificationByByteSlice(groupId, filename string, fileData []byte, isPhoto ...bool) ([]byte, error) {
+	return vi.FaceIdentificationByByteSliceWithOptions(groupId, filename, fileData, AsPhoto(len(isPhoto) > 0 && isPhoto[0]))
+}
+
+// FaceIdentificationByByteSliceWithOptions is FaceIdentificationByByteSlice taking CallOptions, with AsPhoto in place of
+// isPhoto
+func (vi VoiceIt2) FaceIdentificationByByteSliceWithOptions(groupId, filename string, fileData []byte, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	fileFieldKey := "video"
+	if options.photo {
+		fileFieldKey = "photo"
+	}
+
+	part, err := writer.CreateFormFile(fileFieldKey, filename)
+	if err != nil {
+		return []byte{}, errors.New("FaceIdentificationByByteSlice Exception: " + err.Error())
+	}
+
+	if _, err := part.Write(fileData); err != nil {
+		return []byte{}, errors.New("FaceIdentificationByByteSlice Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("groupId", groupId); err != nil {
+		return []byte{}, errors.New("FaceIdentificationByByteSlice Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/identification/face"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("FaceIdentificationByByteSlice Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "FaceIdentificationByByteSlice", req, options)
+}
+
+// FaceIdentificationByUrl takes the groupId generated during a createGroup,
+// and a fully qualified URL to a face recording to idetify the user's face
+// amongst others in the group
+// For more details see https://api.voiceit.io/#identify-a-user-s-face-by-url
+func (vi VoiceIt2) FaceIdentificationByUrl(groupId, fileUrl string, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	if err := writer.WriteField("groupId", groupId); err != nil {
+		return []byte{}, errors.New("FaceIdentificationByUrl Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("fileUrl", fileUrl); err != nil {
+		return []byte{}, errors.New("FaceIdentificationByUrl Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/identification/face/byUrl"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("FaceIdentificationByUrl Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "FaceIdentificationByUrl", req, options)
+}
+
+// GetPhrases takes the contentLanguage
+// For more details see https://api.voiceit.io/#get-phrases
+func (vi VoiceIt2) GetPhrases(contentLanguage string, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	req, err := http.NewRequest("GET", vi.BaseUrl+"/phrases/"+url.PathEscape(contentLanguage)+vi.query(options, nil), nil)
+	if err != nil {
+		return []byte{}, errors.New("GetPhrases Exception: " + err.Error())
+	}
+
+	return vi.do(vi.context(), "GetPhrases", req, options)
+}
+
+// CreateUserToken takes the userId (string) and a timeout (time.Duration).
+// The returned user token can be used to construct a new VoiceIt2 instance which has user level rights for the given user.
+// The timeout controls the expiration of the user token.
+// For more details see https://api.voiceit.io/?go#user-token-generation
+func (vi VoiceIt2) CreateUserToken(userId string, timeout time.Duration, opts ...CallOption) ([]byte, error) {
+	if err := UserID(userId).Validate(); err != nil {
+		return []byte{}, fmt.Errorf("CreateUserToken Exception: %w", err)
+	}
+
+	options := newCallOptions(opts)
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/users/"+url.PathEscape(userId)+"/token"+vi.query(options, url.Values{"timeOut": {strconv.Itoa(int(timeout.Seconds()))}}), nil)
+	if err != nil {
+		return []byte{}, errors.New("CreateUserToken Exception: " + err.Error())
+	}
+
+	return vi.do(vi.context(), "CreateUserToken", req, options)
+}
+
+// ExpireUserTokens takes a userId (string).
+// For more details see https://api.voiceit.io/?go#user-token-expiration
+func (vi VoiceIt2) ExpireUserTokens(userId string, opts ...CallOption) ([]byte, error) {
+	if err := UserID(userId).Validate(); err != nil {
+		return []byte{}, fmt.Errorf("ExpireUserTokens Exception: %w", err)
+	}
+
+	options := newCallOptions(opts)
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/users/"+url.PathEscape(userId)+"/expireTokens"+vi.query(options, nil), nil)
+	if err != nil {
+		return []byte{}, errors.New("ExpireUserTokens Exception: " + err.Error())
+	}
+
+	return vi.do(vi.context(), "ExpireUserTokens", req, options)
+}
+
+// CreateManagedSubAccount creates a managed sub-account.
+func (vi VoiceIt2) CreateManagedSubAccount(params structs.CreateSubAccountRequest, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	if err := writer.WriteField("firstName", params.FirstName); err != nil {
+		return []byte{}, errors.New("CreateManagedSubAccount Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("lastName", params.LastName); err != nil {
+		return []byte{}, errors.New("CreateManagedSubAccount Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("email", params.Email); err != nil {
+		return []byte{}, errors.New("CreateManagedSubAccount Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("password", params.Password); err != nil {
+		return []byte{}, errors.New("CreateManagedSubAccount Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("contentLanguage", params.ContentLanguage); err != nil {
+		return []byte{}, errors.New("CreateManagedSubAccount Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/subaccount/managed"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("CreateManagedSubAccount Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "CreateManagedSubAccount", req, options)
+}
+
+// CreateUnmanagedSubAccount creates an unmanaged sub-account.
+func (vi VoiceIt2) CreateUnmanagedSubAccount(params structs.CreateSubAccountRequest, opts ...CallOption) ([]byte, error) {
+	options := newCallOptions(opts)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	if err := writer.WriteField("firstName", params.FirstName); err != nil {
+		return []byte{}, errors.New("CreateUnmanagedSubAccount Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("lastName", params.LastName); err != nil {
+		return []byte{}, errors.New("CreateUnmanagedSubAccount Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("email", params.Email); err != nil {
+		return []byte{}, errors.New("CreateUnmanagedSubAccount Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("password", params.Password); err != nil {
+		return []byte{}, errors.New("CreateUnmanagedSubAccount Exception: " + err.Error())
+	}
+
+	if err := writer.WriteField("contentLanguage", params.ContentLanguage); err != nil {
+		return []byte{}, errors.New("CreateUnmanagedSubAccount Exception: " + err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/subaccount/unmanaged"+vi.query(options, nil), body)
+	if err != nil {
+		return []byte{}, errors.New("CreateUnmanagedSubAccount Exception: " + err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return vi.do(vi.context(), "CreateUnmanagedSubAccount", req, options)
+}
+
+// RegenerateSubAccountAPIToken takes a subAccountAPIKey (string).
+func (vi VoiceIt2) RegenerateSubAccountAPIToken(subAccountAPIKey string, opts ...CallOption) ([]byte, error) {
+	if err := SubAccountKey(subAccountAPIKey).Validate(); err != nil {
+		return []byte{}, fmt.Errorf("RegenerateSubAccountAPIToken Exception: %w", err)
+	}
+
+	options := newCallOptions(opts)
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/subaccount/"+url.PathEscape(subAccountAPIKey)+vi.query(options, nil), nil)
+	if err != nil {
+		return []byte{}, errors.New("RegenerateSubAccountAPIToken Exception: " + err.Error())
+	}
+
+	return vi.do(vi.context(), "RegenerateSubAccountAPIToken", req, options)
+}
+
+// DeleteSubAccount takes a subAccountAPIKey (string).
+func (vi VoiceIt2) DeleteSubAccount(subAccountAPIKey string, opts ...CallOption) ([]byte, error) {
+	if err := SubAccountKey(subAccountAPIKey).Validate(); err != nil {
+		return []byte{}, fmt.Errorf("DeleteSubAccount Exception: %w", err)
+	}
+
+	options := newCallOptions(opts)
+
+	req, err := http.NewRequest("DELETE", vi.BaseUrl+"/subaccount/"+url.PathEscape(subAccountAPIKey)+vi.query(options, nil), nil)
+	if err != nil {
+		return []byte{}, errors.New("DeleteSubAccount Exception: " + err.Error())
+	}
+
+	return vi.do(vi.context(), "DeleteSubAccount", req, options)
+}
+
+// SwitchSubAccountType takes a subAccountAPIKey (string)  (
+func (vi VoiceIt2) SwitchSubAccountType(subAccountAPIKey string, opts ...CallOption) ([]byte, error) {
+	if err := SubAccountKey(subAccountAPIKey).Validate(); err != nil {
+		return []byte{}, fmt.Errorf("SwitchSubAccountType Exception: %w", err)
+	}
+
+	options := newCallOptions(opts)
+
+	req, err := http.NewRequest("POST", vi.BaseUrl+"/subaccount/"+url.PathEscape(subAccountAPIKey)+"/switchType"+vi.query(options, nil), nil)
+	if err != nil {
+		return []byte{}, errors.New("SwitchSubAccountType Exception: " + err.Error())
+	}
+
+	return vi.do(vi.context(), "SwitchSubAccountType", req, options)
+}
@@ -0,0 +1,141 @@
+package voiceit2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/voiceittech/VoiceIt2-Go/v2/structs"
+)
+
+// Future is the eventual result of a call started with Async
+type Future[T any] struct {
+	done   chan struct{}
+	cancel context.CancelFunc
+	value  T
+	err    error
+}
+
+// Async starts call in a new goroutine and returns a Future for its result.
+// call is given a copy of vi bound to a context that Cancel cancels, which
+// it must use for its API calls, e.g.
+//
+//	f := voiceit2.Async(vi, func(vi voiceit2.VoiceIt2) (voiceit2.IdentifyAndVerifyResult, error) {
+//		return vi.IdentifyAndVerify(req)
+//	})
+func Async[T any](vi VoiceIt2, call func(vi VoiceIt2) (T, error)) *Future[T] {
+	ctx, cancel := context.WithCancel(vi.context())
+	f := &Future[T]{done: make(chan struct{}), cancel: cancel}
+	go func() {
+		defer close(f.done)
+		defer cancel()
+		f.value, f.err = call(vi.WithContext(ctx))
+	}()
+	return f
+}
+
+// Async starts an API call in a new goroutine and returns a Future for its
+// reply, e.g.
+//
+//	f := vi.Async(func(vi voiceit2.VoiceIt2) ([]byte, error) {
+//		return vi.FaceVerification(userId, filePath)
+//	})
+func (vi VoiceIt2) Async(call func(vi VoiceIt2) ([]byte, error)) *Future[[]byte] {
+	return Async(vi, call)
+}
+
+// Done returns a channel that is closed once the call has completed
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+// Cancel cancels the context of the call. A cancelled call completes with
+// an error wrapping context.Canceled unless it had already completed
+func (f *Future[T]) Cancel() {
+	f.cancel()
+}
+
+// Wait waits for the call to complete and returns its result. If ctx is done
+// first Wait returns ctx's error, leaving the call running
+func (f *Future[T]) Wait(ctx context.Context) (T, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	select {
+	case <-f.done:
+		return f.value, f.err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// AsyncCall is one of the calls run by RunAll
+type AsyncCall struct {
+	// Name identifies the call in its AsyncResult and errors
+	Name string
+	Call func(vi VoiceIt2) ([]byte, error)
+	// Result, if set, is a pointer the reply is decoded into, such as a
+	// *structs.FaceVerificationReturn
+	Result interface{}
+}
+
+// AsyncResult is the outcome of an AsyncCall
+type AsyncResult struct {
+	Name  string
+	Reply []byte
+	// Err is a *structs.APIError if the call got a reply without the SUCC
+	// response code
+	Err error
+}
+
+// RunAll runs calls concurrently with vi bound to ctx and waits for all of
+// them. The results are in the order of calls, with replies decoded into the
+// calls' Result. A call fails if it returns an error or a reply without the
+// SUCC response code. The returned error joins the errors of the calls that
+// failed, each prefixed with the call's name. Cancelling ctx cancels the
+// calls still running
+func RunAll(ctx context.Context, vi VoiceIt2, calls ...AsyncCall) ([]AsyncResult, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	vi = vi.WithContext(ctx)
+	futures := make([]*Future[[]byte], len(calls))
+	for i, call := range calls {
+		futures[i] = vi.Async(call.Call)
+	}
+
+	results := make([]AsyncResult, len(calls))
+	var errs []error
+	for i, call := range calls {
+		// The calls share ctx, so waiting on it would only return early
+		reply, err := futures[i].Wait(context.Background())
+		if err == nil {
+			err = decodeAsyncReply(reply, call.Result)
+		}
+		results[i] = AsyncResult{Name: call.Name, Reply: reply, Err: err}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", call.Name, err))
+		}
+	}
+	return results, errors.Join(errs...)
+}
+
+// decodeAsyncReply decodes reply into result, if it is set, and returns an
+// *structs.APIError if reply is not a success
+func decodeAsyncReply(reply []byte, result interface{}) error {
+	var envelope structs.Envelope
+	if err := json.Unmarshal(reply, &envelope); err != nil {
+		return errors.New("RunAll Exception: " + err.Error())
+	}
+	if result != nil {
+		if err := json.Unmarshal(reply, result); err != nil {
+			return errors.New("RunAll Exception: " + err.Error())
+		}
+	}
+	if !envelope.Succeeded() {
+		return &structs.APIError{Envelope: envelope}
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package voiceit2
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/voiceittech/VoiceIt2-Go/v2/structs"
+)
+
+func TestAsync(t *testing.T) {
+	assert := assert.New(t)
+
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/verification/face"):
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC","faceConfidence":97.5}`))
+		case strings.HasPrefix(r.URL.Path, "/verification/voice"):
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC","confidence":91}`))
+		case r.URL.Path == "/groups/grp_gone":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"status":404,"responseCode":"GNFD","message":"Group not found"}`))
+		case r.URL.Path == "/users":
+			select {
+			case <-release:
+			case <-r.Context().Done():
+			}
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC"}`))
+		default:
+			w.Write([]byte(`not json`))
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	myVoiceIt := NewClient("key", "tok", server.URL)
+
+	f := myVoiceIt.Async(func(vi VoiceIt2) ([]byte, error) {
+		return vi.FaceVerificationByByteSlice("usr_1", "face.png", []byte("face"), true)
+	})
+	<-f.Done()
+	reply, err := f.Wait(context.Background())
+	assert.Equal(nil, err)
+	assert.Contains(string(reply), "97.5")
+
+	slow := myVoiceIt.Async(func(vi VoiceIt2) ([]byte, error) {
+		return vi.GetAllUsers()
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = slow.Wait(ctx)
+	assert.True(errors.Is(err, context.DeadlineExceeded), "Wait should give up once its context is done")
+	select {
+	case <-slow.Done():
+		t.Fatal("the call should still be running")
+	default:
+	}
+	slow.Cancel()
+	_, err = slow.Wait(context.Background())
+	assert.True(errors.Is(err, context.Canceled))
+
+	typed := Async(myVoiceIt, func(vi VoiceIt2) (int, error) {
+		return 42, nil
+	})
+	n, err := typed.Wait(context.Background())
+	assert.Equal(nil, err)
+	assert.Equal(42, n)
+
+	var face structs.FaceVerificationReturn
+	var voice structs.VoiceVerificationReturn
+	results, err := RunAll(context.Background(), myVoiceIt,
+		AsyncCall{Name: "face", Result: &face, Call: func(vi VoiceIt2) ([]byte, error) {
+			return vi.FaceVerificationByByteSlice("usr_1", "face.png", []byte("face"))
+		}},
+		AsyncCall{Name: "voice", Result: &voice, Call: func(vi VoiceIt2) ([]byte, error) {
+			return vi.VoiceVerificationByByteSlice("usr_1", "en-US", "my face", "voice.wav", []byte("voice"))
+		}},
+	)
+	assert.Equal(nil, err)
+	assert.Len(results, 2)
+	assert.Equal("face", results[0].Name)
+	assert.Equal(97.5, face.FaceConfidence)
+	assert.Equal(91.0, voice.Confidence)
+
+	var group structs.GetGroupReturn
+	results, err = RunAll(context.Background(), myVoiceIt,
+		AsyncCall{Name: "face", Call: func(vi VoiceIt2) ([]byte, error) {
+			return vi.FaceVerificationByByteSlice("usr_1", "face.png", []byte("face"))
+		}},
+		AsyncCall{Name: "group", Result: &group, Call: func(vi VoiceIt2) ([]byte, error) {
+			return vi.GetGroup("grp_1")
+		}},
+	)
+	assert.Equal(nil, results[0].Err)
+	assert.NotNil(results[1].Err)
+	assert.True(strings.HasPrefix(err.Error(), "group: RunAll Exception: "))
+
+	results, err = RunAll(context.Background(), myVoiceIt,
+		AsyncCall{Name: "group", Result: &group, Call: func(vi VoiceIt2) ([]byte, error) {
+			return vi.GetGroup("grp_gone")
+		}},
+	)
+	var apiErr *structs.APIError
+	if assert.True(errors.As(results[0].Err, &apiErr), "a reply without SUCC should fail the call") {
+		assert.Equal("GNFD", apiErr.ResponseCode)
+	}
+	assert.Equal("GNFD", group.ResponseCode, "the failed reply should still be decoded")
+	assert.Equal("group: GNFD Group not found", err.Error())
+}
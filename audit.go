@@ -0,0 +1,343 @@
+package voiceit2
+
+import (
+	"bufio"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// AuditRecord is one entry of the audit log, describing a single biometric
+// operation. Seq, PrevHash and Hash are filled in by the AuditSink
+type AuditRecord struct {
+	Seq             int64             `json:"seq"`
+	Time            time.Time         `json:"time"`
+	Operation       string            `json:"operation"`
+	UserId          string            `json:"userId,omitempty"`
+	GroupId         string            `json:"groupId,omitempty"`
+	Outcome         string            `json:"outcome"`
+	Status          int               `json:"status,omitempty"`
+	Confidence      *float64          `json:"confidence,omitempty"`
+	FaceConfidence  *float64          `json:"faceConfidence,omitempty"`
+	VoiceConfidence *float64          `json:"voiceConfidence,omitempty"`
+	APICallId       string            `json:"apiCallId,omitempty"`
+	Actor           map[string]string `json:"actor,omitempty"`
+	// Detail describes records written by the log itself
+	Detail   string `json:"detail,omitempty"`
+	PrevHash string `json:"prevHash"`
+	Hash     string `json:"hash"`
+}
+
+// errPartialAuditRecord reports a last line that was cut short, which is what
+// a crash during Append leaves behind
+var errPartialAuditRecord = errors.New("VerifyAuditLog Exception: the last record is incomplete")
+
+// auditOutcomer is implemented by errors that name the outcome to record for
+// the call that returned them
+type auditOutcomer interface {
+	AuditOutcome() string
+}
+
+// AuditSink stores audit records
+type AuditSink interface {
+	Append(record AuditRecord) error
+}
+
+type auditActorKey struct{}
+
+// WithAuditActor returns a context that attributes the API calls made with it,
+// see VoiceIt2.WithContext, to the given actor, e.g. the operator's id and IP
+func WithAuditActor(ctx context.Context, actor map[string]string) context.Context {
+	return context.WithValue(ctx, auditActorKey{}, actor)
+}
+
+// WithAudit records every enrollment, verification, identification, DeleteUser
+// and DeleteAllEnrollments call made by the client in sink. If the record
+// cannot be stored the call returns an error even though it reached the API.
+// Failed calls are recorded with the ERROR outcome unless their error names
+// another one
+func WithAudit(sink AuditSink) Option {
+	return WithMiddleware(auditMiddleware(sink))
+}
+
+// isBiometricOperation reports whether op creates, uses or deletes biometric
+// data
+func isBiometricOperation(op, method string) bool {
+	if op == "DeleteUser" {
+		return true
+	}
+	endpoint := endpointFor(op)
+	return method != http.MethodGet &&
+		(strings.HasPrefix(endpoint, "/enrollments/") ||
+			strings.HasPrefix(endpoint, "/verification/") ||
+			strings.HasPrefix(endpoint, "/identification/"))
+}
+
+func auditMiddleware(sink AuditSink) Middleware {
+	return func(next RoundTrip) RoundTrip {
+		return func(ctx context.Context, op string, req *http.Request) (*Response, error) {
+			if !isBiometricOperation(op, req.Method) {
+				return next(ctx, op, req)
+			}
+
+			params := requestParams(op, req)
+			record := AuditRecord{
+				Time:      time.Now().UTC(),
+				Operation: op,
+				UserId:    params["userId"],
+				GroupId:   params["groupId"],
+			}
+			record.Actor, _ = ctx.Value(auditActorKey{}).(map[string]string)
+
+			resp, err := next(ctx, op, req)
+			var outcomer auditOutcomer
+			switch {
+			case errors.As(err, &outcomer):
+				record.Outcome = outcomer.AuditOutcome()
+			case err != nil:
+				record.Outcome = "ERROR"
+			default:
+				record.Outcome = resp.ResponseCode
+			}
+			if resp != nil {
+				record.Status = resp.Status
+				record.APICallId = resp.APICallId
+				var fields struct {
+					confidenceFields
+					UserId string `json:"userId"`
+				}
+				if json.Unmarshal(resp.Body, &fields) == nil {
+					record.Confidence = fields.Confidence
+					record.FaceConfidence = fields.FaceConfidence
+					record.VoiceConfidence = fields.VoiceConfidence
+					// Identifications name the user they found
+					if record.UserId == "" {
+						record.UserId = fields.UserId
+					}
+				}
+			}
+
+			if auditErr := sink.Append(record); auditErr != nil && err == nil {
+				return resp, errors.New("audit log: " + auditErr.Error())
+			}
+			return resp, err
+		}
+	}
+}
+
+// FileAuditLog is an AuditSink that appends records to a file as JSON lines.
+// Each record is numbered and carries the hash of the previous one, so
+// VerifyAuditLog can detect removed, reordered or modified records. Anyone
+// able to write the file can rebuild the whole chain, so either open it with
+// a secret key or publish its Head elsewhere from time to time
+type FileAuditLog struct {
+	mu       sync.Mutex
+	file     *os.File
+	key      []byte
+	seq      int64
+	lastHash string
+}
+
+// OpenFileAuditLog opens the audit log at path for appending, creating it if
+// needed. An existing log is verified first so the chain continues from its
+// last record. An incomplete last record, left by a crash during Append, is
+// moved to path.partial and replaced by a record saying so
+func OpenFileAuditLog(path string) (*FileAuditLog, error) {
+	return OpenFileAuditLogWithKey(path, nil)
+}
+
+// OpenFileAuditLogWithKey is like OpenFileAuditLog but chains the records
+// with an HMAC-SHA256 keyed by key, so the log can only be rewritten by
+// someone who knows it. Such a log must be checked with VerifyAuditLogWithKey
+func OpenFileAuditLogWithKey(path string, key []byte) (*FileAuditLog, error) {
+	fal := &FileAuditLog{key: key}
+	var setAside int64
+	if existing, err := os.Open(path); err == nil {
+		last, size, err := verifyAuditLog(existing, key)
+		existing.Close()
+		if err == errPartialAuditRecord {
+			setAside, err = setAsidePartialRecord(path, size)
+		}
+		if err != nil {
+			return nil, err
+		}
+		fal.seq, fal.lastHash = last.Seq, last.Hash
+	} else if !os.IsNotExist(err) {
+		return nil, errors.New("FileAuditLog Exception: " + err.Error())
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, errors.New("FileAuditLog Exception: " + err.Error())
+	}
+	fal.file = file
+	if setAside > 0 {
+		err := fal.Append(AuditRecord{
+			Time:      time.Now().UTC(),
+			Operation: "OpenFileAuditLog",
+			Outcome:   "TRUNCATED",
+			Detail:    "moved an incomplete record of " + strconv.FormatInt(setAside, 10) + " bytes to " + path + ".partial",
+		})
+		if err != nil {
+			file.Close()
+			return nil, err
+		}
+	}
+	return fal, nil
+}
+
+// setAsidePartialRecord appends everything after the first size bytes of the
+// log at path to path.partial, then cuts it from the log. It returns the
+// number of bytes moved
+func setAsidePartialRecord(path string, size int64) (int64, error) {
+	log, err := os.Open(path)
+	if err != nil {
+		return 0, errors.New("FileAuditLog Exception: " + err.Error())
+	}
+	partial, err := ioutil.ReadAll(io.NewSectionReader(log, size, 1<<62))
+	log.Close()
+	if err != nil {
+		return 0, errors.New("FileAuditLog Exception: " + err.Error())
+	}
+	aside, err := os.OpenFile(path+".partial", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return 0, errors.New("FileAuditLog Exception: " + err.Error())
+	}
+	if _, err := aside.Write(append(partial, '\n')); err != nil {
+		aside.Close()
+		return 0, errors.New("FileAuditLog Exception: " + err.Error())
+	}
+	if err := aside.Sync(); err != nil {
+		aside.Close()
+		return 0, errors.New("FileAuditLog Exception: " + err.Error())
+	}
+	if err := aside.Close(); err != nil {
+		return 0, errors.New("FileAuditLog Exception: " + err.Error())
+	}
+	if err := os.Truncate(path, size); err != nil {
+		return 0, errors.New("FileAuditLog Exception: " + err.Error())
+	}
+	return int64(len(partial)), nil
+}
+
+func (fal *FileAuditLog) Append(record AuditRecord) error {
+	fal.mu.Lock()
+	defer fal.mu.Unlock()
+
+	record.Seq = fal.seq + 1
+	record.PrevHash = fal.lastHash
+	hash, err := auditRecordHash(record, fal.key)
+	if err != nil {
+		return errors.New("FileAuditLog Exception: " + err.Error())
+	}
+	record.Hash = hash
+	line, err := json.Marshal(record)
+	if err != nil {
+		return errors.New("FileAuditLog Exception: " + err.Error())
+	}
+	if _, err := fal.file.Write(append(line, '\n')); err != nil {
+		return errors.New("FileAuditLog Exception: " + err.Error())
+	}
+	if err := fal.file.Sync(); err != nil {
+		return errors.New("FileAuditLog Exception: " + err.Error())
+	}
+	fal.seq, fal.lastHash = record.Seq, record.Hash
+	return nil
+}
+
+// Head returns the seq and hash of the last record. Stored outside the log,
+// e.g. in another system or a signed message, it pins every record up to it
+func (fal *FileAuditLog) Head() (int64, string) {
+	fal.mu.Lock()
+	defer fal.mu.Unlock()
+	return fal.seq, fal.lastHash
+}
+
+// Close closes the underlying file
+func (fal *FileAuditLog) Close() error {
+	fal.mu.Lock()
+	defer fal.mu.Unlock()
+	return fal.file.Close()
+}
+
+// auditRecordHash returns the hex encoded SHA-256, or HMAC-SHA256 when key is
+// set, of the record's JSON encoding with an empty Hash field
+func auditRecordHash(record AuditRecord, key []byte) (string, error) {
+	record.Hash = ""
+	encoded, err := json.Marshal(record)
+	if err != nil {
+		return "", err
+	}
+	if key == nil {
+		sum := sha256.Sum256(encoded)
+		return hex.EncodeToString(sum[:]), nil
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(encoded)
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// VerifyAuditLog reads an audit log written by FileAuditLog and returns an
+// error describing the first record that is missing, out of order, has been
+// modified or is incomplete
+func VerifyAuditLog(r io.Reader) error {
+	_, _, err := verifyAuditLog(r, nil)
+	return err
+}
+
+// VerifyAuditLogWithKey is like VerifyAuditLog for logs opened with
+// OpenFileAuditLogWithKey
+func VerifyAuditLogWithKey(r io.Reader, key []byte) error {
+	_, _, err := verifyAuditLog(r, key)
+	return err
+}
+
+// verifyAuditLog returns the last record of the log and the size of the
+// complete records in it
+func verifyAuditLog(r io.Reader, key []byte) (AuditRecord, int64, error) {
+	var last AuditRecord
+	var size int64
+	reader := bufio.NewReader(r)
+	for line := 1; ; line++ {
+		data, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			if len(data) > 0 {
+				return last, size, errPartialAuditRecord
+			}
+			return last, size, nil
+		}
+		if err != nil {
+			return last, size, errors.New("VerifyAuditLog Exception: " + err.Error())
+		}
+		var record AuditRecord
+		if err := json.Unmarshal(data, &record); err != nil {
+			return last, size, errors.New("VerifyAuditLog Exception: line " + strconv.Itoa(line) + ": " + err.Error())
+		}
+		if record.Seq != last.Seq+1 {
+			return last, size, errors.New("VerifyAuditLog Exception: line " + strconv.Itoa(line) + ": expected seq " + strconv.FormatInt(last.Seq+1, 10) + ", found " + strconv.FormatInt(record.Seq, 10))
+		}
+		if record.PrevHash != last.Hash {
+			return last, size, errors.New("VerifyAuditLog Exception: line " + strconv.Itoa(line) + ": chain broken before seq " + strconv.FormatInt(record.Seq, 10))
+		}
+		hash, err := auditRecordHash(record, key)
+		if err != nil {
+			return last, size, errors.New("VerifyAuditLog Exception: line " + strconv.Itoa(line) + ": " + err.Error())
+		}
+		if hash != record.Hash {
+			return last, size, errors.New("VerifyAuditLog Exception: line " + strconv.Itoa(line) + ": seq " + strconv.FormatInt(record.Seq, 10) + " has been modified")
+		}
+		last = record
+		size += int64(len(data))
+	}
+}
@@ -0,0 +1,138 @@
+package voiceit2
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditLog(t *testing.T) {
+	assert := assert.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/verification/face":
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC","faceConfidence":97.5,"apiCallId":"api_verify"}`))
+		case "/identification/voice":
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC","userId":"usr_found","confidence":91.0}`))
+		default:
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC"}`))
+		}
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "voiceit2")
+	assert.Equal(nil, err)
+	defer os.RemoveAll(dir)
+	logPath := filepath.Join(dir, "audit.log")
+
+	auditLog, err := OpenFileAuditLog(logPath)
+	assert.Equal(nil, err)
+	myVoiceIt := NewClientWithOptions("key", "tok", WithBaseUrl(server.URL), WithAudit(auditLog))
+	ctx := WithAuditActor(context.Background(), map[string]string{"operator": "alice"})
+
+	myVoiceIt.WithContext(ctx).FaceVerificationByByteSlice("usr_1", "face.jpg", []byte("face"), true)
+	myVoiceIt.VoiceIdentificationByByteSlice("grp_1", "en-US", "phrase", "voice.wav", []byte("voice"))
+	myVoiceIt.GetAllUsers()
+	myVoiceIt.DeleteAllEnrollments("usr_1")
+	assert.Equal(nil, auditLog.Close())
+
+	// Reopening continues the chain
+	auditLog, err = OpenFileAuditLog(logPath)
+	assert.Equal(nil, err)
+	myVoiceIt = NewClientWithOptions("key", "tok", WithBaseUrl(server.URL), WithAudit(auditLog))
+	myVoiceIt.DeleteUser("usr_1")
+	assert.Equal(nil, auditLog.Close())
+
+	contents, err := ioutil.ReadFile(logPath)
+	assert.Equal(nil, err)
+	lines := strings.Split(strings.TrimSpace(string(contents)), "\n")
+	assert.Equal(4, len(lines), "only biometric operations should be audited")
+	assert.Contains(lines[0], `"operation":"FaceVerificationByByteSlice","userId":"usr_1","outcome":"SUCC","status":200,"faceConfidence":97.5,"apiCallId":"api_verify","actor":{"operator":"alice"}`)
+	assert.Contains(lines[1], `"userId":"usr_found","groupId":"grp_1"`)
+	assert.Contains(lines[2], `"operation":"DeleteAllEnrollments","userId":"usr_1"`)
+	assert.Contains(lines[3], `"seq":4`)
+	assert.Equal(nil, VerifyAuditLog(strings.NewReader(string(contents))))
+
+	tampered := strings.Replace(string(contents), `"faceConfidence":97.5`, `"faceConfidence":99.5`, 1)
+	assert.NotEqual(nil, VerifyAuditLog(strings.NewReader(tampered)))
+
+	gap := strings.Join([]string{lines[0], lines[2], lines[3]}, "\n")
+	assert.NotEqual(nil, VerifyAuditLog(strings.NewReader(gap)))
+}
+
+func TestAuditLogRecovery(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "voiceit2")
+	assert.Equal(nil, err)
+	defer os.RemoveAll(dir)
+	logPath := filepath.Join(dir, "audit.log")
+
+	auditLog, err := OpenFileAuditLog(logPath)
+	assert.Equal(nil, err)
+	assert.Equal(nil, auditLog.Append(AuditRecord{Operation: "DeleteUser", Outcome: "SUCC"}))
+	assert.Equal(nil, auditLog.Append(AuditRecord{Operation: "DeleteUser", Outcome: "SUCC"}))
+	assert.Equal(nil, auditLog.Close())
+
+	// A crash in the middle of a write leaves half a record behind
+	file, err := os.OpenFile(logPath, os.O_APPEND|os.O_WRONLY, 0600)
+	assert.Equal(nil, err)
+	file.Write([]byte(`{"seq":3,"time":"2026-`))
+	file.Close()
+	contents, _ := ioutil.ReadFile(logPath)
+	assert.NotEqual(nil, VerifyAuditLog(strings.NewReader(string(contents))))
+
+	auditLog, err = OpenFileAuditLog(logPath)
+	assert.Equal(nil, err, "an incomplete last record should not keep the log from opening")
+	assert.Equal(nil, auditLog.Append(AuditRecord{Operation: "DeleteUser", Outcome: "SUCC"}))
+	seq, _ := auditLog.Head()
+	assert.Equal(int64(4), seq)
+	assert.Equal(nil, auditLog.Close())
+
+	contents, err = ioutil.ReadFile(logPath)
+	assert.Equal(nil, err)
+	assert.Equal(nil, VerifyAuditLog(strings.NewReader(string(contents))))
+	lines := strings.Split(strings.TrimSpace(string(contents)), "\n")
+	assert.Len(lines, 4)
+	assert.Contains(lines[2], `"outcome":"TRUNCATED","detail":"moved an incomplete record of 22 bytes to `)
+
+	// The torn record is kept, not destroyed
+	partial, err := ioutil.ReadFile(logPath + ".partial")
+	assert.Equal(nil, err)
+	assert.Equal(`{"seq":3,"time":"2026-`+"\n", string(partial))
+}
+
+func TestAuditLogKey(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "voiceit2")
+	assert.Equal(nil, err)
+	defer os.RemoveAll(dir)
+	logPath := filepath.Join(dir, "audit.log")
+	key := []byte("secret")
+
+	auditLog, err := OpenFileAuditLogWithKey(logPath, key)
+	assert.Equal(nil, err)
+	assert.Equal(nil, auditLog.Append(AuditRecord{Operation: "DeleteUser", Outcome: "SUCC"}))
+	seq, head := auditLog.Head()
+	assert.Equal(nil, auditLog.Close())
+
+	contents, err := ioutil.ReadFile(logPath)
+	assert.Equal(nil, err)
+	assert.Equal(int64(1), seq)
+	assert.Contains(string(contents), `"hash":"`+head+`"`)
+	assert.Equal(nil, VerifyAuditLogWithKey(strings.NewReader(string(contents)), key))
+	assert.NotEqual(nil, VerifyAuditLog(strings.NewReader(string(contents))), "a keyed log should not verify without the key")
+	assert.NotEqual(nil, VerifyAuditLogWithKey(strings.NewReader(string(contents)), []byte("guess")))
+
+	_, err = OpenFileAuditLog(logPath)
+	assert.NotEqual(nil, err, "a keyed log should not be continued without the key")
+}
@@ -0,0 +1,94 @@
+package voiceit2
+
+import (
+	"net/http"
+	"time"
+)
+
+// CallOption configures a single API call. API methods accept CallOptions as
+// their last arguments, e.g.
+//
+//	vi.CreateUser(WithIdempotencyKey(key), WithTimeout(5*time.Second))
+//
+// The face calls, whose last argument is isPhoto, take CallOptions through
+// their WithOptions variant instead, e.g. FaceVerificationWithOptions
+type CallOption func(*callOptions)
+
+type callOptions struct {
+	// notificationURL overrides the client's NotificationUrl when set
+	notificationURL *string
+	header          http.Header
+	timeout         time.Duration
+	photo           bool
+}
+
+func newCallOptions(opts []CallOption) callOptions {
+	var options callOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return options
+}
+
+// WithNotificationURL sends the call's webhook notification to notificationUrl
+// instead of the client's NotificationUrl. An empty notificationUrl sends no
+// notification for the call
+// For more details, see https://api.voiceit.io/#webhook-notification
+func WithNotificationURL(notificationUrl string) CallOption {
+	return func(o *callOptions) {
+		o.notificationURL = &notificationUrl
+	}
+}
+
+// reservedHeaders are set by the client and cannot be changed by WithHeader
+var reservedHeaders = map[string]bool{
+	"Authorization":   true,
+	"Content-Type":    true,
+	"Content-Length":  true,
+	"Host":            true,
+	"Platformid":      true,
+	"Platformversion": true,
+}
+
+// WithHeader adds a header to the call's request. Headers the client sets
+// itself, such as Authorization, Content-Type and the platform headers, are
+// ignored
+func WithHeader(key, value string) CallOption {
+	return func(o *callOptions) {
+		if reservedHeaders[http.CanonicalHeaderKey(key)] {
+			return
+		}
+		if o.header == nil {
+			o.header = make(http.Header)
+		}
+		o.header.Add(key, value)
+	}
+}
+
+// WithIdempotencyKey sends key in the Idempotency-Key header, so that retries
+// of the call with the same key are not applied twice
+func WithIdempotencyKey(key string) CallOption {
+	return func(o *callOptions) {
+		if o.header == nil {
+			o.header = make(http.Header)
+		}
+		o.header.Set("Idempotency-Key", key)
+	}
+}
+
+// WithTimeout cancels the call if it has not completed after timeout,
+// including the time spent in middleware
+func WithTimeout(timeout time.Duration) CallOption {
+	return func(o *callOptions) {
+		o.timeout = timeout
+	}
+}
+
+// AsPhoto sends the file of a face enrollment, verification or identification
+// made with a WithOptions variant as a photo rather than a video when
+// isPhoto is true. Other calls ignore it
+func AsPhoto(isPhoto bool) CallOption {
+	return func(o *callOptions) {
+		o.photo = isPhoto
+	}
+}
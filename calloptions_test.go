@@ -0,0 +1,72 @@
+package voiceit2
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCallOptions(t *testing.T) {
+	assert := assert.New(t)
+
+	var mu sync.Mutex
+	var requests []*http.Request
+	slowDone := make(chan struct{}, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseMultipartForm(1 << 20)
+		if r.URL.Path == "/users" && r.Method == "GET" {
+			defer func() { slowDone <- struct{}{} }()
+			time.Sleep(50 * time.Millisecond)
+		}
+		mu.Lock()
+		requests = append(requests, r)
+		mu.Unlock()
+		w.Write([]byte(`{"status":200,"responseCode":"SUCC"}`))
+	}))
+	defer server.Close()
+	lastRequest := func() *http.Request {
+		mu.Lock()
+		defer mu.Unlock()
+		return requests[len(requests)-1]
+	}
+
+	myVoiceIt := NewClient("key", "tok", server.URL)
+	myVoiceIt.AddNotificationUrl("https://example.com/hook")
+
+	myVoiceIt.CreateUser()
+	assert.Equal("notificationURL=https%3A%2F%2Fexample.com%2Fhook", lastRequest().URL.RawQuery)
+	myVoiceIt.CreateUser(WithNotificationURL("https://example.com/other"))
+	assert.Equal("notificationURL=https%3A%2F%2Fexample.com%2Fother", lastRequest().URL.RawQuery)
+	myVoiceIt.CreateUser(WithNotificationURL(""))
+	assert.Equal("", lastRequest().URL.RawQuery, "an empty notification URL should disable notifications")
+
+	myVoiceIt.CreateUserToken("usr_1", 30*time.Second, WithNotificationURL("https://example.com/a?b=c&d"))
+	assert.Equal("https://example.com/a?b=c&d", lastRequest().URL.Query().Get("notificationURL"))
+	assert.Equal("30", lastRequest().URL.Query().Get("timeOut"))
+
+	myVoiceIt.CreateUser(WithHeader("X-Request-Id", "req_1"), WithIdempotencyKey("idem_1"), WithHeader("platformId", "0"))
+	assert.Equal("req_1", lastRequest().Header.Get("X-Request-Id"))
+	assert.Equal("idem_1", lastRequest().Header.Get("Idempotency-Key"))
+	assert.Equal(PlatformId, lastRequest().Header.Get("platformId"), "platform headers should not be overridden")
+	myVoiceIt.CreateGroup("staff", WithHeader("content-type", "text/plain"))
+	assert.Contains(lastRequest().Header.Get("Content-Type"), "multipart/form-data", "the content type should not be overridden")
+
+	myVoiceIt.CreateFaceEnrollmentByByteSliceWithOptions("usr_1", "face.png", []byte("face"), AsPhoto(true))
+	assert.NotNil(lastRequest().MultipartForm.File["photo"])
+	myVoiceIt.CreateFaceEnrollmentByByteSliceWithOptions("usr_1", "face.mov", []byte("face"))
+	assert.NotNil(lastRequest().MultipartForm.File["video"])
+	myVoiceIt.CreateFaceEnrollmentByByteSlice("usr_1", "face.png", []byte("face"), true)
+	assert.NotNil(lastRequest().MultipartForm.File["photo"])
+
+	_, err := myVoiceIt.GetAllUsers(WithTimeout(time.Millisecond))
+	assert.True(errors.Is(err, context.DeadlineExceeded))
+	<-slowDone
+	_, err = myVoiceIt.GetAllUsers(WithTimeout(time.Second))
+	assert.Equal(nil, err)
+}
@@ -0,0 +1,19 @@
+package voiceit2
+
+//go:generate go run ./internal/apigen
+//go:generate go run ./internal/mockgen -in client.go,interfaces_gen.go -out voiceit2mock/mock.go
+
+// Client is the whole API surface implemented by VoiceIt2. Code that depends
+// on Client, or on just the parts of it it uses, can be tested against the
+// mock in the voiceit2mock package instead of the API
+type Client interface {
+	Users
+	Groups
+	Enrollments
+	Verification
+	Identification
+	SubAccounts
+	Phrases
+}
+
+var _ Client = VoiceIt2{}
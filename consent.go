@@ -0,0 +1,312 @@
+package voiceit2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/voiceittech/VoiceIt2-Go/v2/structs"
+)
+
+// ErrConsentRequired is returned, wrapped, by enrollment and verification
+// calls refused by the consent guard
+var ErrConsentRequired = errors.New("no valid biometric consent for user")
+
+// ConsentRecord is the consent state of a single user
+type ConsentRecord struct {
+	UserId    string    `json:"userId"`
+	GrantedAt time.Time `json:"grantedAt"`
+	// ExpiresAt is the zero time if the consent does not expire
+	ExpiresAt time.Time `json:"expiresAt"`
+	// RevokedAt is set once the user withdraws consent
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+	// DeletedAt is set once the user's biometric data has been deleted
+	// following the revocation
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+	// History holds the earlier consents of the user, oldest first
+	History []ConsentPeriod `json:"history,omitempty"`
+}
+
+// ConsentPeriod is a consent that has been replaced by a newer one
+type ConsentPeriod struct {
+	GrantedAt time.Time  `json:"grantedAt"`
+	ExpiresAt time.Time  `json:"expiresAt"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+}
+
+// Valid reports whether the consent allows biometric processing at t
+func (cr ConsentRecord) Valid(t time.Time) bool {
+	if cr.RevokedAt != nil || cr.GrantedAt.IsZero() || t.Before(cr.GrantedAt) {
+		return false
+	}
+	return cr.ExpiresAt.IsZero() || t.Before(cr.ExpiresAt)
+}
+
+// ConsentRegistry stores consent records by userId
+type ConsentRegistry interface {
+	Get(userId string) (ConsentRecord, bool, error)
+	Put(record ConsentRecord) error
+	// Update stores the record fn returns for userId, given its current
+	// record if there is one. No other change to the record may happen
+	// between the two. Nothing is stored if fn fails
+	Update(userId string, fn func(record ConsentRecord, ok bool) (ConsentRecord, error)) error
+}
+
+// MemoryConsentRegistry is a ConsentRegistry that keeps records in memory
+type MemoryConsentRegistry struct {
+	mu      sync.RWMutex
+	records map[string]ConsentRecord
+}
+
+// NewMemoryConsentRegistry returns an empty MemoryConsentRegistry
+func NewMemoryConsentRegistry() *MemoryConsentRegistry {
+	return &MemoryConsentRegistry{records: make(map[string]ConsentRecord)}
+}
+
+func (mr *MemoryConsentRegistry) Get(userId string) (ConsentRecord, bool, error) {
+	mr.mu.RLock()
+	defer mr.mu.RUnlock()
+	record, ok := mr.records[userId]
+	return record, ok, nil
+}
+
+func (mr *MemoryConsentRegistry) Put(record ConsentRecord) error {
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+	mr.records[record.UserId] = record
+	return nil
+}
+
+func (mr *MemoryConsentRegistry) Update(userId string, fn func(record ConsentRecord, ok bool) (ConsentRecord, error)) error {
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+	record, ok := mr.records[userId]
+	record, err := fn(record, ok)
+	if err != nil {
+		return err
+	}
+	record.UserId = userId
+	mr.records[userId] = record
+	return nil
+}
+
+// FileConsentRegistry is a ConsentRegistry that keeps records in memory and
+// rewrites them to a JSON file on every change
+type FileConsentRegistry struct {
+	path string
+
+	mu      sync.RWMutex
+	records map[string]ConsentRecord
+}
+
+// OpenFileConsentRegistry loads the consent records stored at path, if the
+// file exists, and returns a FileConsentRegistry that saves to it
+func OpenFileConsentRegistry(path string) (*FileConsentRegistry, error) {
+	fr := &FileConsentRegistry{path: path, records: make(map[string]ConsentRecord)}
+	contents, err := ioutil.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errors.New("FileConsentRegistry Exception: " + err.Error())
+	}
+	if err == nil {
+		if err := json.Unmarshal(contents, &fr.records); err != nil {
+			return nil, errors.New("FileConsentRegistry Exception: " + err.Error())
+		}
+	}
+	return fr, nil
+}
+
+func (fr *FileConsentRegistry) Get(userId string) (ConsentRecord, bool, error) {
+	fr.mu.RLock()
+	defer fr.mu.RUnlock()
+	record, ok := fr.records[userId]
+	return record, ok, nil
+}
+
+func (fr *FileConsentRegistry) Put(record ConsentRecord) error {
+	fr.mu.Lock()
+	defer fr.mu.Unlock()
+	return fr.putLocked(record)
+}
+
+func (fr *FileConsentRegistry) Update(userId string, fn func(record ConsentRecord, ok bool) (ConsentRecord, error)) error {
+	fr.mu.Lock()
+	defer fr.mu.Unlock()
+	record, ok := fr.records[userId]
+	record, err := fn(record, ok)
+	if err != nil {
+		return err
+	}
+	record.UserId = userId
+	return fr.putLocked(record)
+}
+
+// putLocked stores record, keeping the previous one if it cannot be saved.
+// fr.mu must be held
+func (fr *FileConsentRegistry) putLocked(record ConsentRecord) error {
+	previous, existed := fr.records[record.UserId]
+	fr.records[record.UserId] = record
+	if err := fr.save(); err != nil {
+		if existed {
+			fr.records[record.UserId] = previous
+		} else {
+			delete(fr.records, record.UserId)
+		}
+		return err
+	}
+	return nil
+}
+
+// save writes the records to a temporary file and renames it over the
+// registry file, so a crash never leaves a half written registry behind
+func (fr *FileConsentRegistry) save() error {
+	contents, err := json.MarshalIndent(fr.records, "", "  ")
+	if err != nil {
+		return errors.New("FileConsentRegistry Exception: " + err.Error())
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(fr.path), filepath.Base(fr.path)+".tmp")
+	if err != nil {
+		return errors.New("FileConsentRegistry Exception: " + err.Error())
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(contents); err != nil {
+		tmp.Close()
+		return errors.New("FileConsentRegistry Exception: " + err.Error())
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.New("FileConsentRegistry Exception: " + err.Error())
+	}
+	if err := os.Rename(tmp.Name(), fr.path); err != nil {
+		return errors.New("FileConsentRegistry Exception: " + err.Error())
+	}
+	return nil
+}
+
+// GrantConsent records that userId consented to biometric processing until
+// expiresAt, or indefinitely if expiresAt is the zero time. The consent it
+// replaces, if any, is kept in the record's History
+func GrantConsent(registry ConsentRegistry, userId string, expiresAt time.Time) error {
+	err := registry.Update(userId, func(previous ConsentRecord, ok bool) (ConsentRecord, error) {
+		record := ConsentRecord{
+			UserId:    userId,
+			GrantedAt: time.Now().UTC(),
+			ExpiresAt: expiresAt,
+		}
+		if ok {
+			// The stored history must not be appended to in place
+			record.History = make([]ConsentPeriod, len(previous.History), len(previous.History)+1)
+			copy(record.History, previous.History)
+			record.History = append(record.History, ConsentPeriod{
+				GrantedAt: previous.GrantedAt,
+				ExpiresAt: previous.ExpiresAt,
+				RevokedAt: previous.RevokedAt,
+				DeletedAt: previous.DeletedAt,
+			})
+		}
+		return record, nil
+	})
+	if err != nil {
+		return errors.New("GrantConsent Exception: " + err.Error())
+	}
+	return nil
+}
+
+// RevokeConsent records that userId withdrew consent, which the consent guard
+// enforces immediately, then deletes the user's biometric data with vi: all
+// enrollments, or the whole user if deleteUser is set. The deletion is
+// recorded in the registry once the API confirms it, against the revoked
+// consent even if consent was granted again in the meantime
+func RevokeConsent(vi VoiceIt2, registry ConsentRegistry, userId string, deleteUser bool) error {
+	var revokedAt time.Time
+	err := registry.Update(userId, func(record ConsentRecord, ok bool) (ConsentRecord, error) {
+		if record.RevokedAt == nil {
+			now := time.Now().UTC()
+			record.RevokedAt = &now
+		}
+		revokedAt = *record.RevokedAt
+		return record, nil
+	})
+	if err != nil {
+		return errors.New("RevokeConsent Exception: " + err.Error())
+	}
+
+	if deleteUser {
+		reply, err := vi.DeleteUser(userId)
+		if err != nil {
+			return err
+		}
+		if _, apiErr := structs.Decode[structs.DeleteUserReturn](reply); apiErr != nil {
+			return errors.New("RevokeConsent Exception: " + apiErr.Error())
+		}
+	} else {
+		reply, err := vi.DeleteAllEnrollments(userId)
+		if err != nil {
+			return err
+		}
+		if _, apiErr := structs.Decode[structs.DeleteAllEnrollmentsReturn](reply); apiErr != nil {
+			return errors.New("RevokeConsent Exception: " + apiErr.Error())
+		}
+	}
+
+	now := time.Now().UTC()
+	err = registry.Update(userId, func(record ConsentRecord, ok bool) (ConsentRecord, error) {
+		if record.RevokedAt != nil && record.RevokedAt.Equal(revokedAt) {
+			record.DeletedAt = &now
+			return record, nil
+		}
+		// Consent was granted again while the data was being deleted
+		history := make([]ConsentPeriod, len(record.History))
+		copy(history, record.History)
+		for i := range history {
+			if history[i].RevokedAt != nil && history[i].RevokedAt.Equal(revokedAt) {
+				history[i].DeletedAt = &now
+			}
+		}
+		record.History = history
+		return record, nil
+	})
+	if err != nil {
+		return errors.New("RevokeConsent Exception: " + err.Error())
+	}
+	return nil
+}
+
+// WithConsentGuard refuses enrollment and verification calls for users
+// without valid consent in registry. Refused calls fail with an error
+// wrapping ErrConsentRequired and never reach the API
+func WithConsentGuard(registry ConsentRegistry) Option {
+	return WithMiddleware(consentMiddleware(registry))
+}
+
+// requiresConsent reports whether op processes a user's biometric data
+func requiresConsent(op, method string) bool {
+	endpoint := endpointFor(op)
+	return method == http.MethodPost &&
+		(strings.HasPrefix(endpoint, "/enrollments/") || strings.HasPrefix(endpoint, "/verification/"))
+}
+
+func consentMiddleware(registry ConsentRegistry) Middleware {
+	return func(next RoundTrip) RoundTrip {
+		return func(ctx context.Context, op string, req *http.Request) (*Response, error) {
+			if !requiresConsent(op, req.Method) {
+				return next(ctx, op, req)
+			}
+			userId := requestParams(op, req)["userId"]
+			record, ok, err := registry.Get(userId)
+			if err != nil {
+				return nil, err
+			}
+			if !ok || !record.Valid(time.Now()) {
+				return nil, ErrConsentRequired
+			}
+			return next(ctx, op, req)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package voiceit2
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConsentGuard(t *testing.T) {
+	assert := assert.New(t)
+
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.Method+" "+r.URL.Path)
+		w.Write([]byte(`{"status":200,"responseCode":"SUCC"}`))
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "voiceit2")
+	assert.Equal(nil, err)
+	defer os.RemoveAll(dir)
+	registryPath := filepath.Join(dir, "consent.json")
+
+	registry, err := OpenFileConsentRegistry(registryPath)
+	assert.Equal(nil, err)
+	myVoiceIt := NewClientWithOptions("key", "tok", WithBaseUrl(server.URL), WithConsentGuard(registry))
+
+	_, err = myVoiceIt.CreateVoiceEnrollmentByByteSlice("usr_1", "en-US", "phrase", "voice.wav", []byte("voice"))
+	assert.True(errors.Is(err, ErrConsentRequired))
+	assert.Equal(0, len(paths), "refused calls should not reach the API")
+
+	assert.Equal(nil, GrantConsent(registry, "usr_1", time.Time{}))
+	_, err = myVoiceIt.CreateVoiceEnrollmentByByteSlice("usr_1", "en-US", "phrase", "voice.wav", []byte("voice"))
+	assert.Equal(nil, err)
+	_, err = myVoiceIt.FaceVerificationByUrl("usr_1", "https://example.com/face.mp4")
+	assert.Equal(nil, err)
+
+	assert.Equal(nil, GrantConsent(registry, "usr_2", time.Now().Add(-time.Minute)))
+	_, err = myVoiceIt.VoiceVerificationByByteSlice("usr_2", "en-US", "phrase", "voice.wav", []byte("voice"))
+	assert.True(errors.Is(err, ErrConsentRequired), "expired consent should be refused")
+
+	_, err = myVoiceIt.GetAllVoiceEnrollments("usr_2")
+	assert.Equal(nil, err, "listing enrollments does not need consent")
+
+	paths = nil
+	assert.Equal(nil, RevokeConsent(myVoiceIt, registry, "usr_1", false))
+	assert.Equal([]string{"DELETE /enrollments/usr_1/all"}, paths)
+	_, err = myVoiceIt.CreateVoiceEnrollmentByByteSlice("usr_1", "en-US", "phrase", "voice.wav", []byte("voice"))
+	assert.True(errors.Is(err, ErrConsentRequired))
+
+	// The registry survives a reload
+	registry, err = OpenFileConsentRegistry(registryPath)
+	assert.Equal(nil, err)
+	record, ok, err := registry.Get("usr_1")
+	assert.Equal(nil, err)
+	assert.True(ok)
+	assert.NotNil(record.RevokedAt)
+	assert.NotNil(record.DeletedAt)
+	assert.False(record.Valid(time.Now()))
+
+	// Consenting again keeps the revoked consent on record
+	assert.Equal(nil, GrantConsent(registry, "usr_1", time.Time{}))
+	registry, err = OpenFileConsentRegistry(registryPath)
+	assert.Equal(nil, err)
+	record, ok, err = registry.Get("usr_1")
+	assert.Equal(nil, err)
+	assert.True(ok)
+	assert.True(record.Valid(time.Now()))
+	assert.Nil(record.RevokedAt)
+	if assert.Len(record.History, 1) {
+		assert.NotNil(record.History[0].RevokedAt)
+		assert.NotNil(record.History[0].DeletedAt)
+	}
+	guarded := NewClientWithOptions("key", "tok", WithBaseUrl(server.URL), WithConsentGuard(registry))
+	_, err = guarded.CreateVoiceEnrollmentByByteSlice("usr_1", "en-US", "phrase", "voice.wav", []byte("voice"))
+	assert.Equal(nil, err)
+}
+
+func TestRevokeConsentRacingGrant(t *testing.T) {
+	assert := assert.New(t)
+
+	registry := NewMemoryConsentRegistry()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The user consents again while their data is being deleted
+		assert.Equal(nil, GrantConsent(registry, "usr_1", time.Time{}))
+		w.Write([]byte(`{"status":200,"responseCode":"SUCC"}`))
+	}))
+	defer server.Close()
+
+	assert.Equal(nil, GrantConsent(registry, "usr_1", time.Time{}))
+	assert.Equal(nil, RevokeConsent(NewClient("key", "tok", server.URL), registry, "usr_1", false))
+
+	record, ok, err := registry.Get("usr_1")
+	assert.Equal(nil, err)
+	assert.True(ok)
+	assert.True(record.Valid(time.Now()), "the new consent should survive the deletion")
+	assert.Nil(record.DeletedAt)
+	if assert.Len(record.History, 1) {
+		assert.NotNil(record.History[0].RevokedAt)
+		assert.NotNil(record.History[0].DeletedAt, "the deletion should be recorded against the revoked consent")
+	}
+}
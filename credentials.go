@@ -0,0 +1,176 @@
+package voiceit2
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/voiceittech/VoiceIt2-Go/v2/structs"
+)
+
+// CredentialsProvider supplies the API key and token used to authenticate a
+// request. It is consulted on every request, so implementations can rotate
+// credentials without rebuilding clients
+type CredentialsProvider interface {
+	Credentials() (apiKey, apiToken string, err error)
+}
+
+// setAuth applies the client's credentials to req
+func (vi VoiceIt2) setAuth(req *http.Request) error {
+	apiKey, apiToken := vi.APIKey, vi.APIToken
+	if vi.Credentials != nil {
+		var err error
+		if apiKey, apiToken, err = vi.Credentials.Credentials(); err != nil {
+			return err
+		}
+	}
+	req.SetBasicAuth(apiKey, apiToken)
+	return nil
+}
+
+// StaticCredentials is a CredentialsProvider that always returns the same
+// API key and token
+type StaticCredentials struct {
+	APIKey   string
+	APIToken string
+}
+
+func (sc StaticCredentials) Credentials() (string, string, error) {
+	return sc.APIKey, sc.APIToken, nil
+}
+
+// EnvCredentials is a CredentialsProvider that reads the API key and token
+// from the named environment variables on every request
+type EnvCredentials struct {
+	APIKeyVar   string
+	APITokenVar string
+}
+
+func (ec EnvCredentials) Credentials() (string, string, error) {
+	apiKey, apiToken := os.Getenv(ec.APIKeyVar), os.Getenv(ec.APITokenVar)
+	if apiKey == "" || apiToken == "" {
+		return "", "", errors.New("EnvCredentials Exception: " + ec.APIKeyVar + " and " + ec.APITokenVar + " must both be set")
+	}
+	return apiKey, apiToken, nil
+}
+
+// FileCredentials is a CredentialsProvider backed by a JSON file of the form
+// {"apiKey": "...", "apiToken": "..."}. The file is checked for changes at
+// most once per check interval and reloaded when it is modified. If a reload
+// fails, the last credentials read successfully are kept
+type FileCredentials struct {
+	path          string
+	checkInterval time.Duration
+
+	mu        sync.Mutex
+	apiKey    string
+	apiToken  string
+	modTime   time.Time
+	size      int64
+	lastCheck time.Time
+}
+
+type fileCredentialsContents struct {
+	APIKey   string `json:"apiKey"`
+	APIToken string `json:"apiToken"`
+}
+
+// NewFileCredentials reads the credentials file at path and returns a
+// FileCredentials that watches it for changes every checkInterval
+func NewFileCredentials(path string, checkInterval time.Duration) (*FileCredentials, error) {
+	fc := &FileCredentials{path: path, checkInterval: checkInterval}
+	if err := fc.reload(); err != nil {
+		return nil, err
+	}
+	return fc, nil
+}
+
+func (fc *FileCredentials) Credentials() (string, string, error) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	if time.Since(fc.lastCheck) >= fc.checkInterval {
+		fc.lastCheck = time.Now()
+		if info, err := os.Stat(fc.path); err == nil && (!info.ModTime().Equal(fc.modTime) || info.Size() != fc.size) {
+			fc.reloadLocked()
+		}
+	}
+	return fc.apiKey, fc.apiToken, nil
+}
+
+func (fc *FileCredentials) reload() error {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	fc.lastCheck = time.Now()
+	return fc.reloadLocked()
+}
+
+func (fc *FileCredentials) reloadLocked() error {
+	info, err := os.Stat(fc.path)
+	if err != nil {
+		return errors.New("FileCredentials Exception: " + err.Error())
+	}
+	contents, err := ioutil.ReadFile(fc.path)
+	if err != nil {
+		return errors.New("FileCredentials Exception: " + err.Error())
+	}
+	var fcc fileCredentialsContents
+	if err := json.Unmarshal(contents, &fcc); err != nil {
+		return errors.New("FileCredentials Exception: " + err.Error())
+	}
+	if fcc.APIKey == "" || fcc.APIToken == "" {
+		return errors.New("FileCredentials Exception: " + fc.path + " must contain apiKey and apiToken")
+	}
+	fc.apiKey, fc.apiToken = fcc.APIKey, fcc.APIToken
+	fc.modTime, fc.size = info.ModTime(), info.Size()
+	return nil
+}
+
+// RotatingCredentials is a CredentialsProvider whose credentials can be
+// swapped at any time. Share one instance between clients so that a rotation
+// reaches all of them at once; requests already sent keep the credentials
+// they were sent with
+type RotatingCredentials struct {
+	mu       sync.RWMutex
+	apiKey   string
+	apiToken string
+}
+
+// NewRotatingCredentials returns a RotatingCredentials holding the given API
+// key and token
+func NewRotatingCredentials(apiKey, apiToken string) *RotatingCredentials {
+	return &RotatingCredentials{apiKey: apiKey, apiToken: apiToken}
+}
+
+func (rc *RotatingCredentials) Credentials() (string, string, error) {
+	rc.mu.RLock()
+	defer rc.mu.RUnlock()
+	return rc.apiKey, rc.apiToken, nil
+}
+
+// Set replaces the API key and token
+func (rc *RotatingCredentials) Set(apiKey, apiToken string) {
+	rc.mu.Lock()
+	rc.apiKey, rc.apiToken = apiKey, apiToken
+	rc.mu.Unlock()
+}
+
+// RotateSubAccountAPIToken regenerates the API token of the sub-account with
+// the given subAccountAPIKey using the parent account client vi, and swaps
+// the new token into rc so every client built on rc uses it from the next
+// request on
+func RotateSubAccountAPIToken(vi SubAccounts, subAccountAPIKey string, rc *RotatingCredentials) error {
+	reply, err := vi.RegenerateSubAccountAPIToken(subAccountAPIKey)
+	if err != nil {
+		return err
+	}
+	rsa, apiErr := structs.Decode[structs.RegenerateSubAccountAPITokenReturn](reply)
+	if apiErr != nil {
+		return errors.New("RotateSubAccountAPIToken Exception: " + apiErr.Error())
+	}
+	rc.Set(subAccountAPIKey, rsa.APIToken)
+	return nil
+}
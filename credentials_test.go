@@ -0,0 +1,90 @@
+package voiceit2
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCredentialsProviders(t *testing.T) {
+	assert := assert.New(t)
+
+	var lastKey, lastToken string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/subaccount/key_sub" {
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC","apiToken":"tok_new"}`))
+			return
+		}
+		lastKey, lastToken, _ = r.BasicAuth()
+		w.Write([]byte(`{"status":200,"responseCode":"SUCC"}`))
+	}))
+	defer server.Close()
+
+	myVoiceIt := NewClient("key_field", "tok_field", server.URL)
+	myVoiceIt.GetAllUsers()
+	assert.Equal("key_field", lastKey)
+
+	myVoiceIt.Credentials = StaticCredentials{APIKey: "key_static", APIToken: "tok_static"}
+	myVoiceIt.GetAllUsers()
+	assert.Equal("key_static", lastKey, "provider should take precedence over fields")
+
+	t.Setenv("TEST_VIAPIKEY", "key_env")
+	t.Setenv("TEST_VIAPITOKEN", "tok_env")
+	myVoiceIt.Credentials = EnvCredentials{APIKeyVar: "TEST_VIAPIKEY", APITokenVar: "TEST_VIAPITOKEN"}
+	myVoiceIt.GetAllUsers()
+	assert.Equal("tok_env", lastToken)
+
+	myVoiceIt.Credentials = EnvCredentials{APIKeyVar: "TEST_VIAPIKEY_UNSET", APITokenVar: "TEST_VIAPITOKEN_UNSET"}
+	_, err := myVoiceIt.GetAllUsers()
+	assert.NotEqual(nil, err, "missing credentials should fail before sending the request")
+
+	dir, err := ioutil.TempDir("", "voiceit2")
+	assert.Equal(nil, err)
+	defer os.RemoveAll(dir)
+	credentialsFile := filepath.Join(dir, "credentials.json")
+	assert.Equal(nil, ioutil.WriteFile(credentialsFile, []byte(`{"apiKey":"key_file","apiToken":"tok_1"}`), 0600))
+	fc, err := NewFileCredentials(credentialsFile, 0)
+	assert.Equal(nil, err)
+	myVoiceIt.Credentials = fc
+	myVoiceIt.GetAllUsers()
+	assert.Equal("tok_1", lastToken)
+	assert.Equal(nil, ioutil.WriteFile(credentialsFile, []byte(`{"apiKey":"key_file","apiToken":"tok_22"}`), 0600))
+	myVoiceIt.GetAllUsers()
+	assert.Equal("tok_22", lastToken, "changed file should be reloaded")
+	assert.Equal(nil, ioutil.WriteFile(credentialsFile, []byte(`{"apiKey":`), 0600))
+	myVoiceIt.GetAllUsers()
+	assert.Equal("tok_22", lastToken, "broken file should keep the last good credentials")
+}
+
+func TestRotateSubAccountAPIToken(t *testing.T) {
+	assert := assert.New(t)
+
+	var lastToken string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/subaccount/key_sub" {
+			time.Sleep(10 * time.Millisecond)
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC","apiToken":"tok_new"}`))
+			return
+		}
+		_, lastToken, _ = r.BasicAuth()
+		w.Write([]byte(`{"status":200,"responseCode":"SUCC"}`))
+	}))
+	defer server.Close()
+
+	parent := NewClient("key_parent", "tok_parent", server.URL)
+	rc := NewRotatingCredentials("key_sub", "tok_old")
+	sub := NewClient("", "", server.URL)
+	sub.Credentials = rc
+
+	sub.GetAllUsers()
+	assert.Equal("tok_old", lastToken)
+	assert.Equal(nil, RotateSubAccountAPIToken(parent, "key_sub", rc))
+	sub.GetAllUsers()
+	assert.Equal("tok_new", lastToken)
+}
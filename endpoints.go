@@ -0,0 +1,60 @@
+package voiceit2
+
+import (
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// endpointFor returns the path template for op, or "" if op is unknown
+func endpointFor(op string) string {
+	return operationEndpoints[op]
+}
+
+// requestParams returns the parameters an API call was made with: the ids in
+// its path, matched against the endpoint template of op, and the fields of
+// its multipart form. File parts are skipped without being buffered
+func requestParams(op string, req *http.Request) map[string]string {
+	params := make(map[string]string)
+
+	template := strings.Split(strings.Trim(endpointFor(op), "/"), "/")
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	if len(segments) >= len(template) {
+		// BaseUrl may carry a path prefix, so match from the end
+		segments = segments[len(segments)-len(template):]
+		for i, part := range template {
+			if strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") {
+				if value, err := url.PathUnescape(segments[i]); err == nil {
+					params[part[1:len(part)-1]] = value
+				}
+			}
+		}
+	}
+
+	mediaType, mediaParams, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/form-data" || req.GetBody == nil {
+		return params
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return params
+	}
+	defer body.Close()
+	mr := multipart.NewReader(body, mediaParams["boundary"])
+	for {
+		part, err := mr.NextPart()
+		if err != nil {
+			break
+		}
+		if part.FileName() == "" {
+			value, _ := ioutil.ReadAll(io.LimitReader(part, 64<<10))
+			params[part.FormName()] = string(value)
+		}
+		part.Close()
+	}
+	return params
+}
@@ -0,0 +1,69 @@
+// Code generated by internal/apigen from spec/api.json. DO NOT EDIT.
+
+package voiceit2
+
+// operationEndpoints maps each operation to the path template of the endpoint
+// it calls, so middleware can report endpoints without leaking the ids in
+// the actual path
+var operationEndpoints = map[string]string{
+	"GetAllUsers":                           "/users",
+	"CreateUser":                            "/users",
+	"CheckUserExists":                       "/users/{userId}",
+	"DeleteUser":                            "/users/{userId}",
+	"GetGroupsForUser":                      "/users/{userId}/groups",
+	"GetAllGroups":                          "/groups",
+	"GetGroup":                              "/groups/{groupId}",
+	"CheckGroupExists":                      "/groups/{groupId}/exists",
+	"CreateGroup":                           "/groups",
+	"AddUserToGroup":                        "/groups/addUser",
+	"RemoveUserFromGroup":                   "/groups/removeUser",
+	"DeleteGroup":                           "/groups/{groupId}",
+	"GetAllVoiceEnrollments":                "/enrollments/voice/{userId}",
+	"GetAllVideoEnrollments":                "/enrollments/video/{userId}",
+	"GetAllFaceEnrollments":                 "/enrollments/face/{userId}",
+	"CreateVoiceEnrollment":                 "/enrollments/voice",
+	"CreateVoiceEnrollmentByByteSlice":      "/enrollments/voice",
+	"CreateVoiceEnrollmentByUrl":            "/enrollments/voice/byUrl",
+	"CreateFaceEnrollment":                  "/enrollments/face",
+	"CreateFaceEnrollmentByByteSlice":       "/enrollments/face",
+	"CreateFaceEnrollmentByUrl":             "/enrollments/face/byUrl",
+	"CreateVideoEnrollment":                 "/enrollments/video",
+	"CreateVideoEnrollmentByByteSlice":      "/enrollments/video",
+	"CreateSplitVideoEnrollment":            "/enrollments/video",
+	"CreateSplitVideoEnrollmentByByteSlice": "/enrollments/video",
+	"CreateVideoEnrollmentByUrl":            "/enrollments/video/byUrl",
+	"DeleteAllEnrollments":                  "/enrollments/{userId}/all",
+	"DeleteVoiceEnrollment":                 "/enrollments/voice/{userId}/{voiceEnrollmentId}",
+	"DeleteFaceEnrollment":                  "/enrollments/face/{userId}/{faceEnrollmentId}",
+	"DeleteVideoEnrollment":                 "/enrollments/video/{userId}/{videoEnrollmentId}",
+	"VoiceVerification":                     "/verification/voice",
+	"VoiceVerificationByByteSlice":          "/verification/voice",
+	"VoiceVerificationByUrl":                "/verification/voice/byUrl",
+	"FaceVerification":                      "/verification/face",
+	"FaceVerificationByByteSlice":           "/verification/face",
+	"FaceVerificationByUrl":                 "/verification/face/byUrl",
+	"VideoVerification":                     "/verification/video",
+	"VideoVerificationByByteSlice":          "/verification/video",
+	"SplitVideoVerification":                "/verification/video",
+	"SplitVideoVerificationByByteSlice":     "/verification/video",
+	"VideoVerificationByUrl":                "/verification/video/byUrl",
+	"VoiceIdentification":                   "/identification/voice",
+	"VoiceIdentificationByByteSlice":        "/identification/voice",
+	"VoiceIdentificationByUrl":              "/identification/voice/byUrl",
+	"VideoIdentification":                   "/identification/video",
+	"VideoIdentificationByByteSlice":        "/identification/video",
+	"SplitVideoIdentification":              "/identification/video",
+	"SplitVideoIdentificationByByteSlice":   "/identification/video",
+	"VideoIdentificationByUrl":              "/identification/video/byUrl",
+	"FaceIdentification":                    "/identification/face",
+	"FaceIdentificationByByteSlice":         "/identification/face",
+	"FaceIdentificationByUrl":               "/identification/face/byUrl",
+	"GetPhrases":                            "/phrases/{contentLanguage}",
+	"CreateUserToken":                       "/users/{userId}/token",
+	"ExpireUserTokens":                      "/users/{userId}/expireTokens",
+	"CreateManagedSubAccount":               "/subaccount/managed",
+	"CreateUnmanagedSubAccount":             "/subaccount/unmanaged",
+	"RegenerateSubAccountAPIToken":          "/subaccount/{subAccountAPIKey}",
+	"DeleteSubAccount":                      "/subaccount/{subAccountAPIKey}",
+	"SwitchSubAccountType":                  "/subaccount/{subAccountAPIKey}/switchType",
+}
@@ -0,0 +1,124 @@
+package voiceit2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode"
+
+	"github.com/voiceittech/VoiceIt2-Go/v2/structs"
+)
+
+// ErrEnrollmentRejected is returned, wrapped, by voice and video enrollment
+// calls whose enrollment did not pass the client's EnrollmentQualityGate.
+// WithAudit records such calls with the REJECTED outcome
+var ErrEnrollmentRejected error = enrollmentRejectedError{}
+
+type enrollmentRejectedError struct{}
+
+func (enrollmentRejectedError) Error() string { return "enrollment rejected" }
+
+// AuditOutcome is the outcome WithAudit records for rejected enrollments
+func (enrollmentRejectedError) AuditOutcome() string { return "REJECTED" }
+
+// EnrollmentQualityGate checks the text the API recognized in new voice and
+// video enrollments. Enrollments that fail the checks are deleted right away
+// so they never become part of the user's template
+type EnrollmentQualityGate struct {
+	// MinTextConfidence is the lowest TextConfidence accepted
+	MinTextConfidence float64
+	// MatchPhrase also rejects enrollments whose recognized Text is not the
+	// requested phrase, ignoring case, punctuation and spacing
+	MatchPhrase bool
+}
+
+// WithEnrollmentQualityGate makes the client's voice and video enrollment
+// calls fail with ErrEnrollmentRejected, after deleting the enrollment, if
+// the enrollment does not pass gate. The enrollment is deleted through the
+// client like any other call, so the deletion goes through all of its
+// middleware, such as WithAudit, whichever order the options were given in
+func WithEnrollmentQualityGate(gate EnrollmentQualityGate) Option {
+	return WithMiddleware(gate.middleware())
+}
+
+func (gate EnrollmentQualityGate) middleware() Middleware {
+	return func(next RoundTrip) RoundTrip {
+		return func(ctx context.Context, op string, req *http.Request) (*Response, error) {
+			endpoint := endpointFor(op)
+			var modality Modality
+			switch {
+			case req.Method != http.MethodPost:
+			case strings.HasPrefix(endpoint, "/enrollments/voice"):
+				modality = VoiceModality
+			case strings.HasPrefix(endpoint, "/enrollments/video"):
+				modality = VideoModality
+			}
+			if modality == "" {
+				return next(ctx, op, req)
+			}
+
+			params := requestParams(op, req)
+			resp, err := next(ctx, op, req)
+			if err != nil || resp.ResponseCode != "SUCC" {
+				return resp, err
+			}
+			var enrollment struct {
+				Id             int     `json:"id"`
+				Text           string  `json:"text"`
+				TextConfidence float64 `json:"textConfidence"`
+			}
+			if err := json.Unmarshal(resp.Body, &enrollment); err != nil {
+				return resp, nil
+			}
+
+			var reason string
+			switch {
+			case enrollment.TextConfidence < gate.MinTextConfidence:
+				reason = fmt.Sprintf("text confidence %g is below %g", enrollment.TextConfidence, gate.MinTextConfidence)
+			case gate.MatchPhrase && normalizePhrase(enrollment.Text) != normalizePhrase(params["phrase"]):
+				reason = fmt.Sprintf("recognized text %q does not match phrase %q", enrollment.Text, params["phrase"])
+			default:
+				return resp, nil
+			}
+
+			if err := deleteEnrollment(ctx, modality, params["userId"], enrollment.Id); err != nil {
+				return resp, fmt.Errorf("%w: %s, and deleting enrollment %d failed: %v", ErrEnrollmentRejected, reason, enrollment.Id, err)
+			}
+			return resp, fmt.Errorf("%w: %s", ErrEnrollmentRejected, reason)
+		}
+	}
+}
+
+// deleteEnrollment deletes enrollment id with the client making the call
+// bound to ctx
+func deleteEnrollment(ctx context.Context, modality Modality, userId string, id int) error {
+	vi, ok := clientFromContext(ctx)
+	if !ok {
+		return errors.New("no client to delete the enrollment with")
+	}
+	vi = vi.WithContext(ctx)
+	var reply []byte
+	var err error
+	if modality == VoiceModality {
+		reply, err = vi.DeleteVoiceEnrollment(userId, id)
+	} else {
+		reply, err = vi.DeleteVideoEnrollment(userId, id)
+	}
+	if err != nil {
+		return err
+	}
+	if _, apiErr := structs.Decode[structs.Envelope](reply); apiErr != nil {
+		return apiErr
+	}
+	return nil
+}
+
+// normalizePhrase lowercases phrase and keeps only its words
+func normalizePhrase(phrase string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(phrase), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
@@ -0,0 +1,100 @@
+package voiceit2
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/voiceittech/VoiceIt2-Go/v2/structs"
+	"github.com/voiceittech/VoiceIt2-Go/v2/voiceit2test"
+)
+
+func TestEnrollmentQualityGate(t *testing.T) {
+	assert := assert.New(t)
+
+	server := voiceit2test.NewServer()
+	defer server.Close()
+	recognized := map[string]float64{
+		"never forget tomorrow is a new day":  92,
+		"never forget tomorrow is a new bay":  95,
+		"Never forget, tomorrow is a new day": 40,
+	}
+	server.Handle("CreateVoiceEnrollment", func(r *http.Request, params map[string]string) (int, interface{}) {
+		return http.StatusCreated, structs.CreateVoiceEnrollmentReturn{
+			Envelope:       structs.Envelope{Status: 201, ResponseCode: "SUCC"},
+			Id:             len(server.Requests()),
+			Text:           params["phrase"],
+			TextConfidence: recognized[params["phrase"]],
+		}
+	})
+	server.Handle("DeleteVideoEnrollment", func(r *http.Request, params map[string]string) (int, interface{}) {
+		return http.StatusNotFound, structs.DeleteVideoEnrollmentReturn{Envelope: structs.Envelope{Status: 404, ResponseCode: "NFEF", Message: "no enrollment"}}
+	})
+
+	gate := EnrollmentQualityGate{MinTextConfidence: 80, MatchPhrase: true}
+	myVoiceIt := NewClientWithOptions("key", "tok", WithBaseUrl(server.URL), WithEnrollmentQualityGate(gate))
+
+	_, err := myVoiceIt.CreateVoiceEnrollmentByByteSlice("usr_1", "en-US", "never forget tomorrow is a new day", "a.wav", []byte("wav"))
+	assert.Equal(nil, err)
+
+	_, err = myVoiceIt.CreateVoiceEnrollmentByByteSlice("usr_1", "en-US", "Never forget, tomorrow is a new day", "a.wav", []byte("wav"))
+	assert.True(errors.Is(err, ErrEnrollmentRejected))
+	assert.Contains(err.Error(), "text confidence 40 is below 80")
+
+	// The fake server echoes the phrase, so have it differ from the text
+	server.Handle("CreateVideoEnrollment", func(r *http.Request, params map[string]string) (int, interface{}) {
+		return http.StatusCreated, structs.CreateVideoEnrollmentReturn{
+			Envelope:       structs.Envelope{Status: 201, ResponseCode: "SUCC"},
+			Id:             7,
+			Text:           "never forget tomorrow is a new bay",
+			TextConfidence: 95,
+		}
+	})
+	_, err = myVoiceIt.CreateVideoEnrollmentByByteSlice("usr_1", "en-US", "never forget tomorrow is a new day", "a.mov", []byte("mov"))
+	assert.True(errors.Is(err, ErrEnrollmentRejected))
+	assert.Contains(err.Error(), "does not match phrase")
+	assert.Contains(err.Error(), "deleting enrollment 7 failed: NFEF no enrollment")
+
+	requests := server.Requests()
+	assert.Len(requests, 5)
+	assert.Equal("DeleteVoiceEnrollment", requests[2].Operation)
+	assert.Equal("/enrollments/voice/usr_1/2", requests[2].Path)
+	assert.Equal("/enrollments/video/usr_1/7", requests[4].Path)
+
+	_, err = myVoiceIt.CreateFaceEnrollmentByByteSlice("usr_1", "face.png", []byte("face"), true)
+	assert.Equal(nil, err, "face enrollments have no text to check")
+
+	// The deletion goes through all middleware, whichever order the options
+	// were given in
+	var records []AuditRecord
+	sink := auditSinkFunc(func(record AuditRecord) error {
+		records = append(records, record)
+		return nil
+	})
+	audited := NewClientWithOptions("key", "tok", WithBaseUrl(server.URL), WithEnrollmentQualityGate(gate), WithAudit(sink))
+	_, err = audited.CreateVoiceEnrollmentByByteSlice("usr_1", "en-US", "Never forget, tomorrow is a new day", "a.wav", []byte("wav"))
+	assert.True(errors.Is(err, ErrEnrollmentRejected))
+	assert.Len(records, 2)
+	assert.Equal("CreateVoiceEnrollmentByByteSlice", records[0].Operation)
+	assert.Equal("SUCC", records[0].Outcome)
+	assert.Equal("DeleteVoiceEnrollment", records[1].Operation)
+	assert.Equal("SUCC", records[1].Outcome)
+	assert.Equal("usr_1", records[1].UserId)
+
+	records = nil
+	audited = NewClientWithOptions("key", "tok", WithBaseUrl(server.URL), WithAudit(sink), WithEnrollmentQualityGate(gate))
+	_, err = audited.CreateVoiceEnrollmentByByteSlice("usr_1", "en-US", "Never forget, tomorrow is a new day", "a.wav", []byte("wav"))
+	assert.True(errors.Is(err, ErrEnrollmentRejected))
+	assert.Len(records, 2)
+	assert.Equal("DeleteVoiceEnrollment", records[0].Operation)
+	assert.Equal("CreateVoiceEnrollmentByByteSlice", records[1].Operation)
+	assert.Equal("REJECTED", records[1].Outcome, "an outer audit should see the enrollment was created and removed")
+	assert.Equal(201, records[1].Status)
+}
+
+type auditSinkFunc func(record AuditRecord) error
+
+func (f auditSinkFunc) Append(record AuditRecord) error {
+	return f(record)
+}
@@ -0,0 +1,230 @@
+package voiceit2
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/voiceittech/VoiceIt2-Go/v2/structs"
+)
+
+// EnrollmentRequirements are the numbers of enrollments a user needs to
+// verify with each modality. Voice and video enrollments only count towards
+// verification in their own content language. A modality that needs no
+// enrollments is always ready
+type EnrollmentRequirements struct {
+	Voice int `json:"voice"`
+	Face  int `json:"face"`
+	Video int `json:"video"`
+}
+
+// DefaultEnrollmentRequirements are the minimums the API needs to verify
+var DefaultEnrollmentRequirements = EnrollmentRequirements{Voice: 3, Face: 1, Video: 3}
+
+// EnrollmentStatus counts the enrollments of a user
+type EnrollmentStatus struct {
+	UserId string `json:"userId"`
+	Voice  int    `json:"voice"`
+	Face   int    `json:"face"`
+	Video  int    `json:"video"`
+	// VoiceByLanguage and VideoByLanguage count the enrollments by content
+	// language
+	VoiceByLanguage map[string]int `json:"voiceByLanguage"`
+	VideoByLanguage map[string]int `json:"videoByLanguage"`
+}
+
+// EnrollmentStatus fetches the voice, face and video enrollments of the user
+// with userId concurrently and counts them
+func (vi VoiceIt2) EnrollmentStatus(userId string) (EnrollmentStatus, error) {
+	return enrollmentStatus(vi, userId)
+}
+
+func enrollmentStatus(vi Enrollments, userId string) (EnrollmentStatus, error) {
+	status := EnrollmentStatus{UserId: userId, VoiceByLanguage: map[string]int{}, VideoByLanguage: map[string]int{}}
+
+	var voice structs.GetAllVoiceEnrollmentsReturn
+	var face structs.GetAllFaceEnrollmentsReturn
+	var video structs.GetAllVideoEnrollmentsReturn
+	errs := make([]error, 3)
+	var wg sync.WaitGroup
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		errs[0] = decodeEnrollments("voice", &voice)(vi.GetAllVoiceEnrollments(userId))
+	}()
+	go func() {
+		defer wg.Done()
+		errs[1] = decodeEnrollments("face", &face)(vi.GetAllFaceEnrollments(userId))
+	}()
+	go func() {
+		defer wg.Done()
+		errs[2] = decodeEnrollments("video", &video)(vi.GetAllVideoEnrollments(userId))
+	}()
+	wg.Wait()
+	if err := errors.Join(errs...); err != nil {
+		return status, errors.New("EnrollmentStatus Exception: " + err.Error())
+	}
+
+	status.Voice = len(voice.VoiceEnrollments)
+	for _, enrollment := range voice.VoiceEnrollments {
+		status.VoiceByLanguage[enrollment.ContentLanguage]++
+	}
+	status.Face = len(face.FaceEnrollments)
+	status.Video = len(video.VideoEnrollments)
+	for _, enrollment := range video.VideoEnrollments {
+		status.VideoByLanguage[enrollment.ContentLanguage]++
+	}
+	return status, nil
+}
+
+// decodeEnrollments returns a function that decodes the reply of the call
+// fetching the enrollments of the given modality into out
+func decodeEnrollments[T structs.Enveloped](modality string, out *T) func([]byte, error) error {
+	return func(reply []byte, err error) error {
+		if err != nil {
+			return errors.New(modality + ": " + err.Error())
+		}
+		var apiErr *structs.APIError
+		if *out, apiErr = structs.Decode[T](reply); apiErr != nil {
+			return errors.New(modality + ": " + apiErr.Error())
+		}
+		return nil
+	}
+}
+
+// Ready returns the modalities the user has enough enrollments to verify
+// with, in at least one content language for voice and video
+func (s EnrollmentStatus) Ready(requirements EnrollmentRequirements) []Modality {
+	var ready []Modality
+	if maxCount(s.VoiceByLanguage) >= requirements.Voice {
+		ready = append(ready, VoiceModality)
+	}
+	if s.Face >= requirements.Face {
+		ready = append(ready, FaceModality)
+	}
+	if maxCount(s.VideoByLanguage) >= requirements.Video {
+		ready = append(ready, VideoModality)
+	}
+	return ready
+}
+
+func maxCount(counts map[string]int) int {
+	max := 0
+	for _, count := range counts {
+		if count > max {
+			max = count
+		}
+	}
+	return max
+}
+
+// EnrollmentReportOptions controls BuildEnrollmentReport
+type EnrollmentReportOptions struct {
+	// Requirements defaults to DefaultEnrollmentRequirements if nil. To
+	// change a single modality, copy the defaults and change its field
+	Requirements *EnrollmentRequirements
+	// Required are the modalities every user should be able to verify with.
+	// If empty, users are only flagged if they cannot verify at all
+	Required []Modality
+	// Concurrency is the number of users checked at once, 8 if not set
+	Concurrency int
+}
+
+// EnrollmentReportEntry is the enrollment status of a single user
+type EnrollmentReportEntry struct {
+	EnrollmentStatus
+	Ready []Modality `json:"ready"`
+	// BelowMinimum is set if the user cannot verify with every required
+	// modality
+	BelowMinimum bool `json:"belowMinimum"`
+	// Error is set if the user's enrollments could not be fetched
+	Error string `json:"error,omitempty"`
+}
+
+// EnrollmentReport is the enrollment status of every user of the account
+type EnrollmentReport struct {
+	GeneratedAt  time.Time               `json:"generatedAt"`
+	Requirements EnrollmentRequirements  `json:"requirements"`
+	Users        []EnrollmentReportEntry `json:"users"`
+	// BelowMinimum and Failed count the flagged users and the users whose
+	// enrollments could not be fetched
+	BelowMinimum int `json:"belowMinimum"`
+	Failed       int `json:"failed"`
+}
+
+// EnrollmentReportClient is the part of Client that BuildEnrollmentReport
+// uses
+type EnrollmentReportClient interface {
+	Users
+	Enrollments
+}
+
+// BuildEnrollmentReport fetches the enrollment status of every user of the
+// account concurrently. Users are listed in the order GetAllUsers returns
+// them. Failures for single users are recorded in the report and do not stop
+// the run
+func BuildEnrollmentReport(vi EnrollmentReportClient, options EnrollmentReportOptions) (EnrollmentReport, error) {
+	report := EnrollmentReport{GeneratedAt: time.Now(), Requirements: DefaultEnrollmentRequirements}
+	if options.Requirements != nil {
+		report.Requirements = *options.Requirements
+	}
+	concurrency := options.Concurrency
+	if concurrency <= 0 {
+		concurrency = 8
+	}
+
+	reply, err := vi.GetAllUsers()
+	if err != nil {
+		return report, err
+	}
+	gau, apiErr := structs.Decode[structs.GetAllUsersReturn](reply)
+	if apiErr != nil {
+		return report, errors.New("BuildEnrollmentReport Exception: " + apiErr.Error())
+	}
+
+	report.Users = make([]EnrollmentReportEntry, len(gau.Users))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency && w < len(gau.Users); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				report.Users[i] = enrollmentReportEntry(vi, gau.Users[i].UserId, report.Requirements, options.Required)
+			}
+		}()
+	}
+	for i := range gau.Users {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	for _, entry := range report.Users {
+		if entry.Error != "" {
+			report.Failed++
+		} else if entry.BelowMinimum {
+			report.BelowMinimum++
+		}
+	}
+	return report, nil
+}
+
+func enrollmentReportEntry(vi Enrollments, userId string, requirements EnrollmentRequirements, required []Modality) EnrollmentReportEntry {
+	status, err := enrollmentStatus(vi, userId)
+	if err != nil {
+		return EnrollmentReportEntry{EnrollmentStatus: status, Error: err.Error()}
+	}
+	entry := EnrollmentReportEntry{EnrollmentStatus: status, Ready: status.Ready(requirements)}
+	ready := make(map[Modality]bool)
+	for _, modality := range entry.Ready {
+		ready[modality] = true
+	}
+	entry.BelowMinimum = len(entry.Ready) == 0
+	for _, modality := range required {
+		if !ready[modality] {
+			entry.BelowMinimum = true
+		}
+	}
+	return entry
+}
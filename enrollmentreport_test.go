@@ -0,0 +1,76 @@
+package voiceit2
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnrollmentReport(t *testing.T) {
+	assert := assert.New(t)
+
+	voice := func(languages ...string) string {
+		var enrollments []string
+		for _, language := range languages {
+			enrollments = append(enrollments, `{"contentLanguage":"`+language+`"}`)
+		}
+		return `{"status":200,"responseCode":"SUCC","voiceEnrollments":[` + strings.Join(enrollments, ",") + `]}`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/users":
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC","users":[{"userId":"usr_full"},{"userId":"usr_mixed"},{"userId":"usr_none"},{"userId":"usr_broken"}]}`))
+		case "/enrollments/voice/usr_full":
+			w.Write([]byte(voice("en-US", "en-US", "en-US")))
+		case "/enrollments/face/usr_full":
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC","faceEnrollments":[{}]}`))
+		case "/enrollments/video/usr_full":
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC","videoEnrollments":[{"contentLanguage":"en-US"},{"contentLanguage":"en-US"},{"contentLanguage":"en-US"}]}`))
+		case "/enrollments/voice/usr_mixed":
+			w.Write([]byte(voice("en-US", "en-US", "fr-FR")))
+		case "/enrollments/face/usr_broken":
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"status":401,"responseCode":"UNAC","message":"Unauthorized"}`))
+		default:
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC"}`))
+		}
+	}))
+	defer server.Close()
+	myVoiceIt := NewClient("key", "tok", server.URL)
+
+	status, err := myVoiceIt.EnrollmentStatus("usr_mixed")
+	assert.Equal(nil, err)
+	assert.Equal(3, status.Voice)
+	assert.Equal(map[string]int{"en-US": 2, "fr-FR": 1}, status.VoiceByLanguage)
+	assert.Empty(status.Ready(DefaultEnrollmentRequirements), "voice enrollments only count in their own language")
+	assert.Equal([]Modality{VoiceModality}, status.Ready(EnrollmentRequirements{Voice: 2, Face: 1, Video: 1}))
+
+	_, err = myVoiceIt.EnrollmentStatus("usr_broken")
+	if assert.Error(err) {
+		assert.Contains(err.Error(), "UNAC Unauthorized")
+	}
+
+	report, err := BuildEnrollmentReport(myVoiceIt, EnrollmentReportOptions{Required: []Modality{VideoModality}, Concurrency: 2})
+	assert.Equal(nil, err)
+	assert.Len(report.Users, 4)
+	assert.Equal("usr_full", report.Users[0].UserId)
+	assert.Equal([]Modality{VoiceModality, FaceModality, VideoModality}, report.Users[0].Ready)
+	assert.False(report.Users[0].BelowMinimum)
+	assert.True(report.Users[1].BelowMinimum)
+	assert.True(report.Users[2].BelowMinimum)
+	assert.NotEmpty(report.Users[3].Error)
+	assert.Equal(2, report.BelowMinimum)
+	assert.Equal(1, report.Failed)
+	assert.Equal(DefaultEnrollmentRequirements, report.Requirements)
+
+	noFace := DefaultEnrollmentRequirements
+	noFace.Face = 0
+	report, err = BuildEnrollmentReport(myVoiceIt, EnrollmentReportOptions{Requirements: &noFace, Required: []Modality{FaceModality}})
+	assert.Equal(nil, err)
+	assert.Equal(EnrollmentRequirements{Voice: 3, Face: 0, Video: 3}, report.Requirements)
+	assert.Equal(0, report.BelowMinimum, "a modality that needs no enrollments should not flag anyone")
+	assert.Equal(1, report.Failed)
+}
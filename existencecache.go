@@ -0,0 +1,187 @@
+package voiceit2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ExistenceCache coalesces and caches the CheckUserExists and
+// CheckGroupExists calls of the clients configured with WithExistenceCache.
+// Concurrent checks for the same id share a single API call, and successful
+// answers are cached, for PositiveTTL if the user or group exists and for
+// NegativeTTL if it does not. Creating or deleting a user or group through
+// such a client drops what is cached about it. Share an ExistenceCache only
+// between clients of the same account
+type ExistenceCache struct {
+	PositiveTTL time.Duration
+	NegativeTTL time.Duration
+
+	flight flightGroup
+
+	mu         sync.Mutex
+	entries    map[string]existenceEntry
+	generation int
+	stores     int
+}
+
+type existenceEntry struct {
+	resp    Response
+	expires time.Time
+}
+
+// NewExistenceCache returns an ExistenceCache with the given TTLs. A TTL of
+// zero disables caching of that kind of answer, while concurrent checks are
+// still coalesced
+func NewExistenceCache(positiveTTL, negativeTTL time.Duration) *ExistenceCache {
+	return &ExistenceCache{PositiveTTL: positiveTTL, NegativeTTL: negativeTTL}
+}
+
+// WithExistenceCache makes the client answer CheckUserExists and
+// CheckGroupExists through ec, and keeps ec up to date with the users and
+// groups the client creates and deletes
+func WithExistenceCache(ec *ExistenceCache) Option {
+	return WithMiddleware(ec.middleware())
+}
+
+func (ec *ExistenceCache) middleware() Middleware {
+	return func(next RoundTrip) RoundTrip {
+		return func(ctx context.Context, op string, req *http.Request) (*Response, error) {
+			switch op {
+			case "CheckUserExists", "CheckGroupExists":
+				return ec.check(ctx, op, req, next)
+			case "DeleteUser", "DeleteGroup":
+				params := requestParams(op, req)
+				resp, err := next(ctx, op, req)
+				ec.Invalidate(params["userId"] + params["groupId"])
+				return resp, err
+			case "CreateUser", "CreateGroup":
+				resp, err := next(ctx, op, req)
+				if err == nil && resp.ResponseCode == "SUCC" {
+					var created struct {
+						UserId  string `json:"userId"`
+						GroupId string `json:"groupId"`
+					}
+					if json.Unmarshal(resp.Body, &created) == nil {
+						ec.Invalidate(created.UserId + created.GroupId)
+					}
+				}
+				return resp, err
+			}
+			return next(ctx, op, req)
+		}
+	}
+}
+
+func (ec *ExistenceCache) check(ctx context.Context, op string, req *http.Request, next RoundTrip) (*Response, error) {
+	params := requestParams(op, req)
+	id := params["userId"] + params["groupId"]
+	key := op + " " + id
+
+	ec.mu.Lock()
+	entry, ok := ec.entries[key]
+	ec.mu.Unlock()
+	if ok && time.Now().Before(entry.expires) {
+		return copyResponse(&entry.resp), nil
+	}
+
+	type result struct {
+		val interface{}
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		val, err := ec.flight.do(key, func() (interface{}, error) {
+			ec.mu.Lock()
+			generation := ec.generation
+			ec.mu.Unlock()
+
+			// The call is shared, so it must outlive the caller that started it
+			shared := context.WithoutCancel(ctx)
+			return ec.fetch(shared, op, req.WithContext(shared), next, key, generation)
+		})
+		done <- result{val, err}
+	}()
+
+	select {
+	case res := <-done:
+		resp, _ := res.val.(*Response)
+		if resp != nil {
+			// Callers sharing the call must not see each other's changes
+			resp = copyResponse(resp)
+		}
+		return resp, res.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// fetch makes the check and caches its answer
+func (ec *ExistenceCache) fetch(ctx context.Context, op string, req *http.Request, next RoundTrip, key string, generation int) (*Response, error) {
+	resp, err := next(ctx, op, req)
+	if err != nil || resp.ResponseCode != "SUCC" {
+		return resp, err
+	}
+	var answer struct {
+		Exists bool `json:"exists"`
+	}
+	if json.Unmarshal(resp.Body, &answer) != nil {
+		return resp, nil
+	}
+	ttl := ec.NegativeTTL
+	if answer.Exists {
+		ttl = ec.PositiveTTL
+	}
+	if ttl > 0 {
+		ec.store(key, generation, existenceEntry{resp: *copyResponse(resp), expires: time.Now().Add(ttl)})
+	}
+	return resp, nil
+}
+
+// copyResponse returns a deep copy of resp
+func copyResponse(resp *Response) *Response {
+	copied := *resp
+	copied.Body = append([]byte(nil), resp.Body...)
+	copied.Header = resp.Header.Clone()
+	return &copied
+}
+
+func (ec *ExistenceCache) store(key string, generation int, entry existenceEntry) {
+	ec.mu.Lock()
+	defer ec.mu.Unlock()
+	// Answers fetched before an Invalidate may already be stale
+	if generation != ec.generation {
+		return
+	}
+	if ec.entries == nil {
+		ec.entries = make(map[string]existenceEntry)
+	}
+	ec.entries[key] = entry
+	ec.stores++
+	if ec.stores%1024 == 0 {
+		now := time.Now()
+		for k, e := range ec.entries {
+			if !now.Before(e.expires) {
+				delete(ec.entries, k)
+			}
+		}
+	}
+}
+
+// Invalidate drops what is cached about the given userIds and groupIds, or
+// everything if none are given
+func (ec *ExistenceCache) Invalidate(ids ...string) {
+	ec.mu.Lock()
+	defer ec.mu.Unlock()
+	ec.generation++
+	if len(ids) == 0 {
+		ec.entries = nil
+		return
+	}
+	for _, id := range ids {
+		delete(ec.entries, "CheckUserExists "+id)
+		delete(ec.entries, "CheckGroupExists "+id)
+	}
+}
@@ -0,0 +1,128 @@
+package voiceit2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExistenceCache(t *testing.T) {
+	assert := assert.New(t)
+
+	var mu sync.Mutex
+	checks := 0
+	users := map[string]bool{"usr_1": true}
+	groups := map[string]bool{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.Method == "GET" && strings.HasPrefix(r.URL.Path, "/users/"):
+			checks++
+			time.Sleep(10 * time.Millisecond)
+			exists := users[strings.TrimPrefix(r.URL.Path, "/users/")]
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC","exists":` + map[bool]string{true: "true", false: "false"}[exists] + `}`))
+		case r.Method == "GET" && strings.HasSuffix(r.URL.Path, "/exists"):
+			checks++
+			exists := groups[strings.Split(r.URL.Path, "/")[2]]
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC","exists":` + map[bool]string{true: "true", false: "false"}[exists] + `}`))
+		case r.Method == "DELETE":
+			delete(users, strings.TrimPrefix(r.URL.Path, "/users/"))
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC"}`))
+		case r.Method == "POST" && r.URL.Path == "/groups":
+			groups["grp_1"] = true
+			w.Write([]byte(`{"status":201,"responseCode":"SUCC","groupId":"grp_1"}`))
+		}
+	}))
+	defer server.Close()
+
+	ec := NewExistenceCache(time.Minute, 30*time.Millisecond)
+	myVoiceIt := NewClientWithOptions("key", "tok", WithBaseUrl(server.URL), WithExistenceCache(ec))
+
+	var wg sync.WaitGroup
+	replies := make([]string, 10)
+	for i := range replies {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			reply, _ := myVoiceIt.CheckUserExists("usr_1")
+			replies[i] = string(reply)
+		}(i)
+	}
+	wg.Wait()
+	assert.Equal(1, checks, "concurrent checks should share a single call")
+	for _, reply := range replies {
+		assert.Contains(reply, `"exists":true`)
+	}
+	myVoiceIt.CheckUserExists("usr_1")
+	assert.Equal(1, checks, "positive answers should be cached")
+
+	reply, _ := myVoiceIt.CheckGroupExists("grp_1")
+	assert.Contains(string(reply), `"exists":false`)
+	myVoiceIt.CheckGroupExists("grp_1")
+	assert.Equal(2, checks, "negative answers should be cached")
+	time.Sleep(40 * time.Millisecond)
+	myVoiceIt.CheckGroupExists("grp_1")
+	assert.Equal(3, checks, "negative answers should expire after their TTL")
+
+	_, err := myVoiceIt.CreateGroup("staff")
+	assert.Equal(nil, err)
+	reply, _ = myVoiceIt.CheckGroupExists("grp_1")
+	assert.Contains(string(reply), `"exists":true`, "CreateGroup should drop the cached negative answer")
+
+	_, err = myVoiceIt.DeleteUser("usr_1")
+	assert.Equal(nil, err)
+	reply, _ = myVoiceIt.CheckUserExists("usr_1")
+	assert.Contains(string(reply), `"exists":false`, "DeleteUser should drop the cached positive answer")
+
+	checks = 0
+	ec.Invalidate()
+	myVoiceIt.CheckUserExists("usr_1")
+	myVoiceIt.CheckGroupExists("grp_1")
+	assert.Equal(2, checks)
+}
+
+func TestExistenceCacheCancelledLeader(t *testing.T) {
+	assert := assert.New(t)
+
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Write([]byte(`{"status":200,"responseCode":"SUCC","exists":true}`))
+	}))
+	defer server.Close()
+
+	ec := NewExistenceCache(time.Minute, time.Minute)
+	myVoiceIt := NewClientWithOptions("key", "tok", WithBaseUrl(server.URL), WithExistenceCache(ec))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	leader := make(chan error)
+	go func() {
+		_, err := myVoiceIt.WithContext(ctx).CheckUserExists("usr_1")
+		leader <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+	follower := make(chan string)
+	go func() {
+		reply, _ := myVoiceIt.CheckUserExists("usr_1")
+		follower <- string(reply)
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	cancel()
+	assert.Error(<-leader, "a cancelled caller should stop waiting")
+	close(release)
+	assert.Contains(<-follower, `"exists":true`, "cancelling one caller should not fail the others")
+
+	// Each caller gets its own copy of the cached body
+	first, _ := myVoiceIt.CheckUserExists("usr_1")
+	first[0] = 'x'
+	second, _ := myVoiceIt.CheckUserExists("usr_1")
+	assert.Equal(byte('{'), second[0])
+}
@@ -1,8 +1,10 @@
 module github.com/voiceittech/VoiceIt2-Go/v2
 
-go 1.12
+go 1.21
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
 	github.com/stretchr/testify v1.5.1
+	gopkg.in/yaml.v2 v2.2.2 // indirect
 )
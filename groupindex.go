@@ -0,0 +1,279 @@
+package voiceit2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/voiceittech/VoiceIt2-Go/v2/structs"
+)
+
+// GroupIndex is a local index of the account's groups by description. It is
+// loaded from GetAllGroups on first use and kept up to date with the groups
+// created and deleted through it, or through any client configured with
+// WithGroupIndex. Changes made by other processes only show up after Refresh
+type GroupIndex struct {
+	vi VoiceIt2
+	// flight coalesces EnsureGroup calls by description and refresh the loads
+	flight  flightGroup
+	refresh flightGroup
+
+	mu            sync.RWMutex
+	loaded        bool
+	groups        map[string]structs.Group
+	byDescription map[string][]string
+	// refreshing counts the Refresh calls in flight. While there are any,
+	// changes are also kept in changes, to be applied on top of listings
+	// that may predate them
+	refreshing int
+	changes    []groupChange
+}
+
+type groupChange struct {
+	group   structs.Group
+	removed bool
+}
+
+// NewGroupIndex returns a GroupIndex that looks groups up with vi
+func NewGroupIndex(vi VoiceIt2) *GroupIndex {
+	gi := &GroupIndex{}
+	gi.vi = vi.With(WithGroupIndex(gi))
+	return gi
+}
+
+// WithGroupIndex keeps gi up to date with the groups created and deleted by
+// the client
+func WithGroupIndex(gi *GroupIndex) Option {
+	return WithMiddleware(gi.middleware())
+}
+
+func (gi *GroupIndex) middleware() Middleware {
+	return func(next RoundTrip) RoundTrip {
+		return func(ctx context.Context, op string, req *http.Request) (*Response, error) {
+			if op != "CreateGroup" && op != "DeleteGroup" {
+				return next(ctx, op, req)
+			}
+			params := requestParams(op, req)
+			resp, err := next(ctx, op, req)
+			if err != nil || resp.ResponseCode != "SUCC" {
+				return resp, err
+			}
+			if op == "DeleteGroup" {
+				gi.remove(params["groupId"])
+				return resp, err
+			}
+			var cg structs.CreateGroupReturn
+			if json.Unmarshal(resp.Body, &cg) == nil && cg.GroupId != "" {
+				gi.add(structs.Group{GroupId: cg.GroupId, Description: params["description"], CreatedAt: cg.CreatedAt})
+			}
+			return resp, err
+		}
+	}
+}
+
+// Refresh reloads the index from GetAllGroups. Groups created or deleted
+// through the index while the listing is fetched are kept or dropped even if
+// the listing does not reflect them yet
+func (gi *GroupIndex) Refresh() error {
+	gi.mu.Lock()
+	gi.refreshing++
+	start := len(gi.changes)
+	gi.mu.Unlock()
+	defer func() {
+		gi.mu.Lock()
+		gi.refreshing--
+		if gi.refreshing == 0 {
+			gi.changes = nil
+		}
+		gi.mu.Unlock()
+	}()
+
+	reply, err := gi.vi.GetAllGroups()
+	if err != nil {
+		return err
+	}
+	gag, apiErr := structs.Decode[structs.GetAllGroupsReturn](reply)
+	if apiErr != nil {
+		return errors.New("GroupIndex Exception: " + apiErr.Error())
+	}
+
+	gi.mu.Lock()
+	defer gi.mu.Unlock()
+	gi.groups = make(map[string]structs.Group)
+	gi.byDescription = make(map[string][]string)
+	for _, group := range gag.Groups {
+		gi.insert(group)
+	}
+	for _, change := range gi.changes[start:] {
+		if change.removed {
+			gi.delete(change.group.GroupId)
+		} else {
+			gi.insert(change.group)
+		}
+	}
+	gi.loaded = true
+	return nil
+}
+
+func (gi *GroupIndex) load() error {
+	gi.mu.RLock()
+	loaded := gi.loaded
+	gi.mu.RUnlock()
+	if loaded {
+		return nil
+	}
+	_, err := gi.refresh.do("", func() (interface{}, error) {
+		return nil, gi.Refresh()
+	})
+	return err
+}
+
+// insert adds group, keeping the groups sharing its description ordered
+// oldest first. gi.mu must be held
+func (gi *GroupIndex) insert(group structs.Group) {
+	group.Users = nil
+	if _, ok := gi.groups[group.GroupId]; ok {
+		return
+	}
+	gi.groups[group.GroupId] = group
+	ids := append(gi.byDescription[group.Description], group.GroupId)
+	sort.SliceStable(ids, func(i, j int) bool { return gi.groups[ids[i]].CreatedAt.Before(gi.groups[ids[j]].CreatedAt.Time) })
+	gi.byDescription[group.Description] = ids
+}
+
+func (gi *GroupIndex) add(group structs.Group) {
+	gi.mu.Lock()
+	defer gi.mu.Unlock()
+	if gi.refreshing > 0 {
+		gi.changes = append(gi.changes, groupChange{group: group})
+	}
+	if gi.loaded {
+		gi.insert(group)
+	}
+}
+
+func (gi *GroupIndex) remove(groupId string) {
+	gi.mu.Lock()
+	defer gi.mu.Unlock()
+	if gi.refreshing > 0 {
+		gi.changes = append(gi.changes, groupChange{group: structs.Group{GroupId: groupId}, removed: true})
+	}
+	if gi.loaded {
+		gi.delete(groupId)
+	}
+}
+
+// delete drops the group with groupId. gi.mu must be held
+func (gi *GroupIndex) delete(groupId string) {
+	group, ok := gi.groups[groupId]
+	if !ok {
+		return
+	}
+	delete(gi.groups, groupId)
+	ids := gi.byDescription[group.Description]
+	for i, id := range ids {
+		if id == groupId {
+			ids = append(ids[:i:i], ids[i+1:]...)
+			break
+		}
+	}
+	if len(ids) == 0 {
+		delete(gi.byDescription, group.Description)
+	} else {
+		gi.byDescription[group.Description] = ids
+	}
+}
+
+// FindByDescription returns the groups whose description is exactly
+// description, oldest first. Only the GroupId, Description and CreatedAt
+// fields are set
+func (gi *GroupIndex) FindByDescription(description string) ([]structs.Group, error) {
+	if err := gi.load(); err != nil {
+		return nil, err
+	}
+	gi.mu.RLock()
+	defer gi.mu.RUnlock()
+	return gi.lookup(gi.byDescription[description]), nil
+}
+
+// FindByPrefix returns the groups whose description starts with prefix,
+// ordered by description and then oldest first
+func (gi *GroupIndex) FindByPrefix(prefix string) ([]structs.Group, error) {
+	if err := gi.load(); err != nil {
+		return nil, err
+	}
+	gi.mu.RLock()
+	defer gi.mu.RUnlock()
+	var found []structs.Group
+	for _, description := range sortedKeys(gi.byDescription) {
+		if strings.HasPrefix(description, prefix) {
+			found = append(found, gi.lookup(gi.byDescription[description])...)
+		}
+	}
+	return found, nil
+}
+
+// Description returns the description of the group with groupId
+func (gi *GroupIndex) Description(groupId string) (string, bool, error) {
+	if err := gi.load(); err != nil {
+		return "", false, err
+	}
+	gi.mu.RLock()
+	defer gi.mu.RUnlock()
+	group, ok := gi.groups[groupId]
+	return group.Description, ok, nil
+}
+
+func (gi *GroupIndex) lookup(ids []string) []structs.Group {
+	groups := make([]structs.Group, len(ids))
+	for i, id := range ids {
+		groups[i] = gi.groups[id]
+	}
+	return groups
+}
+
+// EnsureGroup returns the groupId of the oldest group with description,
+// creating the group if there is none. Concurrent calls for the same
+// description through the same GroupIndex create at most one group; created
+// is true only for the call whose request created it. Calls made by other
+// processes are not coordinated with, so they can still create a second group
+// with the same description
+func (gi *GroupIndex) EnsureGroup(description string) (groupId string, created bool, err error) {
+	groups, err := gi.FindByDescription(description)
+	if err != nil {
+		return "", false, err
+	}
+	if len(groups) > 0 {
+		return groups[0].GroupId, false, nil
+	}
+
+	type result struct {
+		groupId string
+		created bool
+	}
+	leader := false
+	val, err := gi.flight.do(description, func() (interface{}, error) {
+		leader = true
+		// A call that finished just before this one may have created it
+		gi.mu.RLock()
+		ids := gi.byDescription[description]
+		gi.mu.RUnlock()
+		if len(ids) > 0 {
+			return result{groupId: ids[0]}, nil
+		}
+		groupId, err := createGroup(gi.vi, description)
+		if err != nil {
+			return nil, errors.New("EnsureGroup Exception: " + err.Error())
+		}
+		return result{groupId: groupId, created: true}, nil
+	})
+	if err != nil {
+		return "", false, err
+	}
+	r := val.(result)
+	return r.groupId, r.created && leader, nil
+}
@@ -0,0 +1,126 @@
+package voiceit2
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/voiceittech/VoiceIt2-Go/v2/structs"
+)
+
+func TestGroupIndex(t *testing.T) {
+	assert := assert.New(t)
+
+	fg := &fakeGroups{groups: []structs.Group{
+		{GroupId: "grp_a", Description: "store/berlin", CreatedAt: structs.TimestampMillis(2)},
+		{GroupId: "grp_b", Description: "store/berlin", CreatedAt: structs.TimestampMillis(1)},
+		{GroupId: "grp_c", Description: "store/paris", CreatedAt: structs.TimestampMillis(3)},
+		{GroupId: "grp_d", Description: "staff", CreatedAt: structs.TimestampMillis(4)},
+	}}
+	server := httptest.NewServer(fg)
+	defer server.Close()
+	myVoiceIt := NewClient("key", "tok", server.URL)
+	gi := NewGroupIndex(myVoiceIt)
+
+	groups, err := gi.FindByDescription("store/berlin")
+	assert.Equal(nil, err)
+	assert.Equal([]string{"grp_b", "grp_a"}, groupIds(groups), "oldest first")
+
+	groups, err = gi.FindByPrefix("store/")
+	assert.Equal(nil, err)
+	assert.Equal([]string{"grp_b", "grp_a", "grp_c"}, groupIds(groups))
+
+	description, ok, err := gi.Description("grp_d")
+	assert.Equal(nil, err)
+	assert.True(ok)
+	assert.Equal("staff", description)
+
+	groupId, created, err := gi.EnsureGroup("store/berlin")
+	assert.Equal(nil, err)
+	assert.False(created)
+	assert.Equal("grp_b", groupId)
+
+	var wg sync.WaitGroup
+	ids := make([]string, 10)
+	createdBy := make([]bool, 10)
+	for i := range ids {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ids[i], createdBy[i], _ = gi.EnsureGroup("store/rome")
+		}(i)
+	}
+	wg.Wait()
+	assert.Equal(1, fg.writes, "concurrent EnsureGroup calls should create a single group")
+	creators := 0
+	for _, c := range createdBy {
+		if c {
+			creators++
+		}
+	}
+	assert.Equal(1, creators, "only the call that created the group should report it")
+	for _, id := range ids {
+		assert.Equal(ids[0], id)
+	}
+
+	// Changes made through a client wired to the index show up in it
+	tracked := myVoiceIt.With(WithGroupIndex(gi))
+	_, err = tracked.DeleteGroup(ids[0])
+	assert.Equal(nil, err)
+	groups, _ = gi.FindByDescription("store/rome")
+	assert.Empty(groups)
+	_, err = tracked.CreateGroup("store/rome")
+	assert.Equal(nil, err)
+	groups, _ = gi.FindByDescription("store/rome")
+	assert.Len(groups, 1)
+}
+
+func TestGroupIndexRefreshDuringChanges(t *testing.T) {
+	assert := assert.New(t)
+
+	listing := make(chan struct{})
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			// The listing is taken before the changes below are made
+			close(listing)
+			<-release
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC","groups":[{"groupId":"grp_gone","description":"staff"}]}`))
+		case http.MethodPost:
+			w.Write([]byte(`{"status":201,"responseCode":"SUCC","groupId":"grp_new"}`))
+		default:
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC"}`))
+		}
+	}))
+	defer server.Close()
+	myVoiceIt := NewClient("key", "tok", server.URL)
+	gi := NewGroupIndex(myVoiceIt)
+	tracked := myVoiceIt.With(WithGroupIndex(gi))
+
+	refreshed := make(chan error)
+	go func() { refreshed <- gi.Refresh() }()
+	<-listing
+	_, err := tracked.CreateGroup("store/rome")
+	assert.Equal(nil, err)
+	_, err = tracked.DeleteGroup("grp_gone")
+	assert.Equal(nil, err)
+	close(release)
+	assert.Equal(nil, <-refreshed)
+
+	groups, err := gi.FindByDescription("store/rome")
+	assert.Equal(nil, err)
+	assert.Equal([]string{"grp_new"}, groupIds(groups), "a group created during Refresh should be kept")
+	_, ok, _ := gi.Description("grp_gone")
+	assert.False(ok, "a group deleted during Refresh should be dropped")
+}
+
+func groupIds(groups []structs.Group) []string {
+	ids := make([]string, len(groups))
+	for i, group := range groups {
+		ids[i] = group.GroupId
+	}
+	return ids
+}
@@ -0,0 +1,196 @@
+package voiceit2
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+
+	"github.com/voiceittech/VoiceIt2-Go/v2/structs"
+)
+
+// GroupSyncStep is a single change of a GroupSyncPlan
+type GroupSyncStep struct {
+	// Action is one of "createGroup", "addUser", "removeUser" or "deleteGroup"
+	Action      string `json:"action"`
+	Description string `json:"description"`
+	// GroupId is empty for groups the plan creates until it is applied
+	GroupId string `json:"groupId,omitempty"`
+	UserId  string `json:"userId,omitempty"`
+	// Error is set if applying the step failed
+	Error string `json:"error,omitempty"`
+}
+
+// GroupSyncPlan lists the changes needed to bring the account's groups in
+// line with the desired state
+type GroupSyncPlan struct {
+	Steps []GroupSyncStep `json:"steps"`
+}
+
+// GroupSyncOptions controls SyncGroups
+type GroupSyncOptions struct {
+	// DeleteUnlisted deletes groups whose description is not in the desired
+	// state. Without it such groups are left untouched
+	DeleteUnlisted bool
+	// DryRun only plans the changes without applying them
+	DryRun bool
+}
+
+// SyncGroups makes the account's groups match desired, a mapping of group
+// descriptions to the userIds that should be members, and returns the plan it
+// applied. Groups are matched by description; if several groups share a
+// description the oldest is kept in sync and the others are treated as
+// unlisted. Running SyncGroups again once it succeeded plans no changes
+func SyncGroups(vi Groups, desired map[string][]string, options GroupSyncOptions) (GroupSyncPlan, error) {
+	plan, err := PlanGroupSync(vi, desired, options.DeleteUnlisted)
+	if err != nil || options.DryRun {
+		return plan, err
+	}
+	return ApplyGroupSync(vi, plan)
+}
+
+// PlanGroupSync diffs desired against the account's groups and returns the
+// changes SyncGroups would make, without making any
+func PlanGroupSync(vi Groups, desired map[string][]string, deleteUnlisted bool) (GroupSyncPlan, error) {
+	var plan GroupSyncPlan
+
+	reply, err := vi.GetAllGroups()
+	if err != nil {
+		return plan, err
+	}
+	gag, apiErr := structs.Decode[structs.GetAllGroupsReturn](reply)
+	if apiErr != nil {
+		return plan, errors.New("PlanGroupSync Exception: " + apiErr.Error())
+	}
+
+	groups := gag.Groups
+	sort.SliceStable(groups, func(i, j int) bool { return groups[i].CreatedAt.Before(groups[j].CreatedAt.Time) })
+	existing := make(map[string]structs.Group)
+	var unlisted []structs.Group
+	for _, group := range groups {
+		if _, wanted := desired[group.Description]; !wanted {
+			unlisted = append(unlisted, group)
+			continue
+		}
+		if _, seen := existing[group.Description]; seen {
+			unlisted = append(unlisted, group)
+			continue
+		}
+		// Listings may omit the members of large groups
+		if len(group.Users) != group.UserCount {
+			if group.Users, err = groupMembers(vi, group.GroupId); err != nil {
+				return plan, err
+			}
+		}
+		existing[group.Description] = group
+	}
+
+	for _, description := range sortedKeys(desired) {
+		want := make(map[string]bool)
+		for _, userId := range desired[description] {
+			want[userId] = true
+		}
+
+		group, ok := existing[description]
+		if !ok {
+			plan.Steps = append(plan.Steps, GroupSyncStep{Action: "createGroup", Description: description})
+		}
+		have := make(map[string]bool)
+		for _, userId := range group.Users {
+			have[userId] = true
+		}
+		for _, userId := range sortedKeys(want) {
+			if !have[userId] {
+				plan.Steps = append(plan.Steps, GroupSyncStep{Action: "addUser", Description: description, GroupId: group.GroupId, UserId: userId})
+			}
+		}
+		for _, userId := range sortedKeys(have) {
+			if !want[userId] {
+				plan.Steps = append(plan.Steps, GroupSyncStep{Action: "removeUser", Description: description, GroupId: group.GroupId, UserId: userId})
+			}
+		}
+	}
+
+	if deleteUnlisted {
+		for _, group := range unlisted {
+			plan.Steps = append(plan.Steps, GroupSyncStep{Action: "deleteGroup", Description: group.Description, GroupId: group.GroupId})
+		}
+	}
+	return plan, nil
+}
+
+// ApplyGroupSync makes the changes in plan, in order, and returns it with the
+// groupIds of created groups filled in. A failed step is recorded in its
+// Error field; steps that depend on a group that could not be created are
+// skipped, all others are still attempted. The returned error reports the
+// number of failed steps
+func ApplyGroupSync(vi Groups, plan GroupSyncPlan) (GroupSyncPlan, error) {
+	applied := GroupSyncPlan{Steps: make([]GroupSyncStep, len(plan.Steps))}
+	created := make(map[string]string)
+	failed := 0
+	for i, step := range plan.Steps {
+		if step.GroupId == "" && step.Action != "createGroup" {
+			step.GroupId = created[step.Description]
+		}
+
+		var err error
+		switch {
+		case step.Action == "createGroup":
+			step.GroupId, err = createGroup(vi, step.Description)
+			created[step.Description] = step.GroupId
+		case step.GroupId == "":
+			err = errors.New("group " + step.Description + " was not created")
+		case step.Action == "addUser":
+			err = groupCall(vi.AddUserToGroup(step.GroupId, step.UserId))
+		case step.Action == "removeUser":
+			err = groupCall(vi.RemoveUserFromGroup(step.GroupId, step.UserId))
+		case step.Action == "deleteGroup":
+			err = groupCall(vi.DeleteGroup(step.GroupId))
+		default:
+			err = errors.New("unknown action " + step.Action)
+		}
+		if err != nil {
+			step.Error = err.Error()
+			failed++
+		}
+		applied.Steps[i] = step
+	}
+	if failed > 0 {
+		return applied, errors.New("ApplyGroupSync Exception: " + strconv.Itoa(failed) + " of " + strconv.Itoa(len(plan.Steps)) + " steps failed")
+	}
+	return applied, nil
+}
+
+func groupMembers(vi Groups, groupId string) ([]string, error) {
+	reply, err := vi.GetGroup(groupId)
+	if err != nil {
+		return nil, err
+	}
+	gg, apiErr := structs.Decode[structs.GetGroupReturn](reply)
+	if apiErr != nil {
+		return nil, errors.New("PlanGroupSync Exception: " + apiErr.Error())
+	}
+	return gg.Users, nil
+}
+
+func createGroup(vi Groups, description string) (string, error) {
+	reply, err := vi.CreateGroup(description)
+	if err != nil {
+		return "", err
+	}
+	cg, apiErr := structs.Decode[structs.CreateGroupReturn](reply)
+	if apiErr != nil {
+		return "", apiErr
+	}
+	return cg.GroupId, nil
+}
+
+// groupCall checks the reply of a group membership or deletion call
+func groupCall(reply []byte, err error) error {
+	if err != nil {
+		return err
+	}
+	if _, apiErr := structs.Decode[structs.DeleteGroupReturn](reply); apiErr != nil {
+		return apiErr
+	}
+	return nil
+}
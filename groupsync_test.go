@@ -0,0 +1,123 @@
+package voiceit2
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/voiceittech/VoiceIt2-Go/v2/structs"
+)
+
+// fakeGroups serves the group endpoints from memory
+type fakeGroups struct {
+	mu     sync.Mutex
+	groups []structs.Group
+	writes int
+}
+
+func (fg *fakeGroups) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	fg.mu.Lock()
+	defer fg.mu.Unlock()
+	find := func(groupId string) *structs.Group {
+		for i := range fg.groups {
+			if fg.groups[i].GroupId == groupId {
+				return &fg.groups[i]
+			}
+		}
+		return nil
+	}
+	succ := func(v interface{}) {
+		json.NewEncoder(w).Encode(v)
+	}
+
+	if r.Method != http.MethodGet {
+		fg.writes++
+	}
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/groups":
+		listed := make([]structs.Group, len(fg.groups))
+		for i, group := range fg.groups {
+			listed[i] = group
+			listed[i].Users = append([]string(nil), group.Users...)
+		}
+		succ(structs.GetAllGroupsReturn{Envelope: structs.Envelope{ResponseCode: "SUCC"}, Groups: listed})
+	case r.Method == http.MethodPost && r.URL.Path == "/groups":
+		group := structs.Group{
+			GroupId:     "grp_" + strconv.Itoa(len(fg.groups)+1),
+			Description: r.FormValue("description"),
+			CreatedAt:   structs.TimestampMillis(int64(len(fg.groups) + 1)),
+		}
+		fg.groups = append(fg.groups, group)
+		succ(structs.CreateGroupReturn{Envelope: structs.Envelope{ResponseCode: "SUCC"}, GroupId: group.GroupId})
+	case r.Method == http.MethodPut && r.URL.Path == "/groups/addUser":
+		group := find(r.FormValue("groupId"))
+		group.Users = append(group.Users, r.FormValue("userId"))
+		group.UserCount++
+		succ(structs.AddUserToGroupReturn{Envelope: structs.Envelope{ResponseCode: "SUCC"}})
+	case r.Method == http.MethodPut && r.URL.Path == "/groups/removeUser":
+		group := find(r.FormValue("groupId"))
+		for i, userId := range group.Users {
+			if userId == r.FormValue("userId") {
+				group.Users = append(group.Users[:i], group.Users[i+1:]...)
+				group.UserCount--
+				break
+			}
+		}
+		succ(structs.RemoveUserFromGroupReturn{Envelope: structs.Envelope{ResponseCode: "SUCC"}})
+	case r.Method == http.MethodDelete:
+		groupId := strings.TrimPrefix(r.URL.Path, "/groups/")
+		for i := range fg.groups {
+			if fg.groups[i].GroupId == groupId {
+				fg.groups = append(fg.groups[:i], fg.groups[i+1:]...)
+				break
+			}
+		}
+		succ(structs.DeleteGroupReturn{Envelope: structs.Envelope{ResponseCode: "SUCC"}})
+	}
+}
+
+func TestSyncGroups(t *testing.T) {
+	assert := assert.New(t)
+
+	fg := &fakeGroups{groups: []structs.Group{
+		{GroupId: "grp_a", Description: "admins", CreatedAt: structs.TimestampMillis(1), Users: []string{"usr_1", "usr_2"}, UserCount: 2},
+		{GroupId: "grp_b", Description: "legacy", CreatedAt: structs.TimestampMillis(2)},
+		{GroupId: "grp_c", Description: "admins", CreatedAt: structs.TimestampMillis(3)},
+	}}
+	server := httptest.NewServer(fg)
+	defer server.Close()
+	myVoiceIt := NewClient("key", "tok", server.URL)
+
+	desired := map[string][]string{
+		"admins":   {"usr_1", "usr_3"},
+		"cashiers": {"usr_4"},
+	}
+
+	plan, err := SyncGroups(myVoiceIt, desired, GroupSyncOptions{DeleteUnlisted: true, DryRun: true})
+	assert.Equal(nil, err)
+	assert.Equal([]GroupSyncStep{
+		{Action: "addUser", Description: "admins", GroupId: "grp_a", UserId: "usr_3"},
+		{Action: "removeUser", Description: "admins", GroupId: "grp_a", UserId: "usr_2"},
+		{Action: "createGroup", Description: "cashiers"},
+		{Action: "addUser", Description: "cashiers", UserId: "usr_4"},
+		{Action: "deleteGroup", Description: "legacy", GroupId: "grp_b"},
+		{Action: "deleteGroup", Description: "admins", GroupId: "grp_c"},
+	}, plan.Steps)
+	assert.Equal(0, fg.writes, "a dry run should not change anything")
+
+	plan, err = SyncGroups(myVoiceIt, desired, GroupSyncOptions{DeleteUnlisted: true})
+	assert.Equal(nil, err)
+	assert.Equal("grp_4", plan.Steps[3].GroupId, "added users should go to the created group")
+	assert.Equal(6, fg.writes)
+
+	plan, err = SyncGroups(myVoiceIt, desired, GroupSyncOptions{DeleteUnlisted: true})
+	assert.Equal(nil, err)
+	assert.Empty(plan.Steps, "a second sync should have nothing to do")
+	assert.Equal(6, fg.writes)
+	assert.Len(fg.groups, 2)
+}
@@ -0,0 +1,157 @@
+package voiceit2
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// Modality selects which biometric an identification or verification uses
+type Modality string
+
+const (
+	VoiceModality Modality = "voice"
+	FaceModality  Modality = "face"
+	VideoModality Modality = "video"
+)
+
+func (m Modality) valid() bool {
+	return m == VoiceModality || m == FaceModality || m == VideoModality
+}
+
+// IdentifyAndVerifyRequest describes a combined identification and
+// verification of a single recording
+type IdentifyAndVerifyRequest struct {
+	GroupId string
+	// ContentLanguage and Phrase are required for voice and video modalities
+	ContentLanguage string
+	Phrase          string
+	Filename        string
+	FileData        []byte
+	// IsPhoto marks FileData as a photo rather than a video for the face
+	// modality
+	IsPhoto bool
+
+	IdentifyBy Modality
+	VerifyBy   Modality
+	// MinIdentificationConfidence and MinVerificationConfidence are the
+	// lowest confidences, between 0 and 100, each step must return. For the
+	// video modality both the voice and the face confidence must clear them.
+	// The verification may not be less strict than the identification, so
+	// MinVerificationConfidence must be at least MinIdentificationConfidence
+	MinIdentificationConfidence float64
+	MinVerificationConfidence   float64
+}
+
+// StepResult is the outcome of one step of IdentifyAndVerify
+type StepResult struct {
+	ResponseCode    string  `json:"responseCode"`
+	Message         string  `json:"message"`
+	APICallId       string  `json:"apiCallId"`
+	UserId          string  `json:"userId"`
+	Confidence      float64 `json:"confidence"`
+	FaceConfidence  float64 `json:"faceConfidence"`
+	VoiceConfidence float64 `json:"voiceConfidence"`
+	// Score is the confidence the threshold was applied to: the lowest
+	// confidence the modality returns
+	Score float64 `json:"-"`
+	// Passed is true if the step succeeded and Score cleared its threshold
+	Passed bool `json:"-"`
+}
+
+// IdentifyAndVerifyResult is the combined outcome of IdentifyAndVerify
+type IdentifyAndVerifyResult struct {
+	UserId         string
+	Identification StepResult
+	// Verification is the zero value if the identification did not pass
+	Verification StepResult
+	// Accepted is true only if both steps passed
+	Accepted bool
+}
+
+// IdentifyAndVerify identifies who is speaking or shown in the recording
+// amongst the members of the group, then verifies the identified user with
+// the same recording. The verification only runs if the identification
+// passed its threshold. The modalities and thresholds are checked before any
+// call is made
+func (vi VoiceIt2) IdentifyAndVerify(req IdentifyAndVerifyRequest) (IdentifyAndVerifyResult, error) {
+	return IdentifyAndVerify(vi, req)
+}
+
+// IdentifyAndVerifyClient is the part of Client that IdentifyAndVerify uses
+type IdentifyAndVerifyClient interface {
+	Identification
+	Verification
+}
+
+// IdentifyAndVerify is VoiceIt2.IdentifyAndVerify for any client that can
+// identify and verify, such as a voiceit2mock.Client
+func IdentifyAndVerify(vi IdentifyAndVerifyClient, req IdentifyAndVerifyRequest) (IdentifyAndVerifyResult, error) {
+	var result IdentifyAndVerifyResult
+	if !req.IdentifyBy.valid() {
+		return result, errors.New("IdentifyAndVerify Exception: unknown identification modality " + string(req.IdentifyBy))
+	}
+	if !req.VerifyBy.valid() {
+		return result, errors.New("IdentifyAndVerify Exception: unknown verification modality " + string(req.VerifyBy))
+	}
+	if req.MinVerificationConfidence < req.MinIdentificationConfidence {
+		return result, errors.New("IdentifyAndVerify Exception: MinVerificationConfidence is below MinIdentificationConfidence")
+	}
+
+	var reply []byte
+	var err error
+	switch req.IdentifyBy {
+	case VoiceModality:
+		reply, err = vi.VoiceIdentificationByByteSlice(req.GroupId, req.ContentLanguage, req.Phrase, req.Filename, req.FileData)
+	case FaceModality:
+		reply, err = vi.FaceIdentificationByByteSlice(req.GroupId, req.Filename, req.FileData, req.IsPhoto)
+	case VideoModality:
+		reply, err = vi.VideoIdentificationByByteSlice(req.GroupId, req.ContentLanguage, req.Phrase, req.Filename, req.FileData)
+	}
+	if err != nil {
+		return result, err
+	}
+	if result.Identification, err = decodeStepResult(reply, req.IdentifyBy, req.MinIdentificationConfidence); err != nil {
+		return result, err
+	}
+	if !result.Identification.Passed || result.Identification.UserId == "" {
+		return result, nil
+	}
+	result.UserId = result.Identification.UserId
+
+	switch req.VerifyBy {
+	case VoiceModality:
+		reply, err = vi.VoiceVerificationByByteSlice(result.UserId, req.ContentLanguage, req.Phrase, req.Filename, req.FileData)
+	case FaceModality:
+		reply, err = vi.FaceVerificationByByteSlice(result.UserId, req.Filename, req.FileData, req.IsPhoto)
+	case VideoModality:
+		reply, err = vi.VideoVerificationByByteSlice(result.UserId, req.ContentLanguage, req.Phrase, req.Filename, req.FileData)
+	}
+	if err != nil {
+		return result, err
+	}
+	if result.Verification, err = decodeStepResult(reply, req.VerifyBy, req.MinVerificationConfidence); err != nil {
+		return result, err
+	}
+	result.Accepted = result.Verification.Passed
+	return result, nil
+}
+
+func decodeStepResult(reply []byte, modality Modality, threshold float64) (StepResult, error) {
+	var sr StepResult
+	if err := json.Unmarshal(reply, &sr); err != nil {
+		return sr, errors.New("IdentifyAndVerify Exception: " + err.Error())
+	}
+	switch modality {
+	case VoiceModality:
+		sr.Score = sr.Confidence
+	case FaceModality:
+		sr.Score = sr.FaceConfidence
+	case VideoModality:
+		sr.Score = sr.VoiceConfidence
+		if sr.FaceConfidence < sr.Score {
+			sr.Score = sr.FaceConfidence
+		}
+	}
+	sr.Passed = sr.ResponseCode == "SUCC" && sr.Score >= threshold
+	return sr, nil
+}
@@ -0,0 +1,81 @@
+package voiceit2
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdentifyAndVerify(t *testing.T) {
+	assert := assert.New(t)
+
+	var verifiedUser string
+	identifications := 0
+	videoFaceConfidence := "99.0"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/identification/voice":
+			identifications++
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC","userId":"usr_found","groupId":"grp_1","confidence":88.0}`))
+		case "/verification/video":
+			verifiedUser = r.FormValue("userId")
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC","voiceConfidence":95.0,"faceConfidence":` + videoFaceConfidence + `}`))
+		}
+	}))
+	defer server.Close()
+
+	myVoiceIt := NewClient("key", "tok", server.URL)
+	req := IdentifyAndVerifyRequest{
+		GroupId:                     "grp_1",
+		ContentLanguage:             "en-US",
+		Phrase:                      "never forget tomorrow is a new day",
+		Filename:                    "video.mp4",
+		FileData:                    []byte("video"),
+		IdentifyBy:                  VoiceModality,
+		VerifyBy:                    VideoModality,
+		MinIdentificationConfidence: 85,
+		MinVerificationConfidence:   90,
+	}
+
+	result, err := myVoiceIt.IdentifyAndVerify(req)
+	assert.Equal(nil, err)
+	assert.True(result.Accepted)
+	assert.Equal("usr_found", result.UserId)
+	assert.Equal("usr_found", verifiedUser, "verification should target the identified user")
+	assert.Equal(88.0, result.Identification.Score)
+	assert.Equal(95.0, result.Verification.Score)
+
+	videoFaceConfidence = "70.0"
+	result, err = myVoiceIt.IdentifyAndVerify(req)
+	assert.Equal(nil, err)
+	assert.False(result.Accepted, "both video confidences should clear the threshold")
+	assert.Equal(70.0, result.Verification.Score)
+
+	verifiedUser = ""
+	req.MinIdentificationConfidence = 90
+	result, err = myVoiceIt.IdentifyAndVerify(req)
+	assert.Equal(nil, err)
+	assert.False(result.Accepted)
+	assert.Equal("", verifiedUser, "verification should not run after a weak identification")
+
+	identifications = 0
+	req.VerifyBy = "fingerprint"
+	req.MinIdentificationConfidence = 0
+	_, err = myVoiceIt.IdentifyAndVerify(req)
+	assert.NotEqual(nil, err)
+	assert.Equal(0, identifications, "an unknown modality should be rejected before identifying")
+
+	req.VerifyBy = VideoModality
+	req.IdentifyBy = "fingerprint"
+	_, err = myVoiceIt.IdentifyAndVerify(req)
+	assert.NotEqual(nil, err)
+	assert.Equal(0, identifications)
+
+	req.IdentifyBy = VoiceModality
+	req.MinIdentificationConfidence = 95
+	_, err = myVoiceIt.IdentifyAndVerify(req)
+	assert.NotEqual(nil, err)
+	assert.Equal(0, identifications, "a verification less strict than the identification should be rejected")
+}
@@ -0,0 +1,52 @@
+package voiceit2
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// ErrInvalidID is returned, wrapped, by calls given an identifier that does
+// not have the documented format. Such calls never reach the API. Versions up
+// to v2.7.1 sent them anyway and returned the API's error response instead
+var ErrInvalidID = errors.New("invalid identifier")
+
+var (
+	userIDPattern        = regexp.MustCompile(`^usr_[A-Za-z0-9]+$`)
+	groupIDPattern       = regexp.MustCompile(`^grp_[A-Za-z0-9]+$`)
+	subAccountKeyPattern = regexp.MustCompile(`^key_[A-Za-z0-9]+$`)
+)
+
+// UserID is a userId returned by CreateUser, e.g. usr_49b8c1f3e9a84bdd8c0fe8a14b52a0c5
+type UserID string
+
+// Validate returns an error wrapping ErrInvalidID unless id has the form
+// usr_ followed by letters and digits
+func (id UserID) Validate() error {
+	return validateID("userId", "usr_", string(id), userIDPattern)
+}
+
+// GroupID is a groupId returned by CreateGroup, e.g. grp_3a8b6d3e0c5f4b0c9e1d2f7a6b5c4d3e
+type GroupID string
+
+// Validate returns an error wrapping ErrInvalidID unless id has the form
+// grp_ followed by letters and digits
+func (id GroupID) Validate() error {
+	return validateID("groupId", "grp_", string(id), groupIDPattern)
+}
+
+// SubAccountKey is the API key of a sub-account, e.g. key_6f1d6e5c4b3a29180f7e6d5c4b3a2918
+type SubAccountKey string
+
+// Validate returns an error wrapping ErrInvalidID unless key has the form
+// key_ followed by letters and digits
+func (key SubAccountKey) Validate() error {
+	return validateID("subAccountAPIKey", "key_", string(key), subAccountKeyPattern)
+}
+
+func validateID(name, prefix, value string, pattern *regexp.Regexp) error {
+	if !pattern.MatchString(value) {
+		return fmt.Errorf("%w: %s %q must be %s followed by letters and digits", ErrInvalidID, name, value, prefix)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package voiceit2
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIDValidation(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(nil, UserID("usr_49b8c1f3e9a84bdd8c0fe8a14b52a0c5").Validate())
+	assert.Equal(nil, GroupID("grp_3a8b6d3e").Validate())
+	assert.Equal(nil, SubAccountKey("key_6f1d6e5c").Validate())
+	for _, id := range []string{"", "usr_", "grp_1", "usr_1/../../groups", "usr_1?x=1", "usr_.."} {
+		assert.True(errors.Is(UserID(id).Validate(), ErrInvalidID), id)
+	}
+	assert.True(errors.Is(GroupID("usr_1").Validate(), ErrInvalidID))
+	assert.True(errors.Is(SubAccountKey("key_a/b").Validate(), ErrInvalidID))
+
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.EscapedPath())
+		w.Write([]byte(`{"responseCode":"SUCC"}`))
+	}))
+	defer server.Close()
+	myVoiceIt := NewClient("key", "tok", server.URL)
+
+	_, err := myVoiceIt.CheckUserExists("usr_1/../../groups")
+	assert.True(errors.Is(err, ErrInvalidID))
+	_, err = myVoiceIt.DeleteGroup("grp_1?all=true")
+	assert.True(errors.Is(err, ErrInvalidID))
+	_, err = myVoiceIt.DeleteSubAccount("../managed")
+	assert.True(errors.Is(err, ErrInvalidID))
+	assert.Empty(paths, "invalid identifiers should never reach the API")
+
+	_, err = myVoiceIt.GetPhrases("en-US/../x")
+	assert.Equal(nil, err)
+	assert.Equal([]string{"/phrases/en-US%2F..%2Fx"}, paths)
+}
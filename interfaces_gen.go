@@ -0,0 +1,103 @@
+// Code generated by internal/apigen from spec/api.json. DO NOT EDIT.
+
+package voiceit2
+
+import (
+	"time"
+
+	"github.com/voiceittech/VoiceIt2-Go/v2/structs"
+)
+
+// Users covers the user and user token endpoints
+type Users interface {
+	GetAllUsers(opts ...CallOption) ([]byte, error)
+	CreateUser(opts ...CallOption) ([]byte, error)
+	CheckUserExists(userId string, opts ...CallOption) ([]byte, error)
+	DeleteUser(userId string, opts ...CallOption) ([]byte, error)
+	GetGroupsForUser(userId string, opts ...CallOption) ([]byte, error)
+	CreateUserToken(userId string, timeout time.Duration, opts ...CallOption) ([]byte, error)
+	ExpireUserTokens(userId string, opts ...CallOption) ([]byte, error)
+}
+
+// Groups covers the group endpoints
+type Groups interface {
+	GetAllGroups(opts ...CallOption) ([]byte, error)
+	GetGroup(groupId string, opts ...CallOption) ([]byte, error)
+	CheckGroupExists(groupId string, opts ...CallOption) ([]byte, error)
+	CreateGroup(description string, opts ...CallOption) ([]byte, error)
+	AddUserToGroup(groupId, userId string, opts ...CallOption) ([]byte, error)
+	RemoveUserFromGroup(groupId, userId string, opts ...CallOption) ([]byte, error)
+	DeleteGroup(groupId string, opts ...CallOption) ([]byte, error)
+}
+
+// Enrollments covers the enrollment endpoints
+type Enrollments interface {
+	GetAllVoiceEnrollments(userId string, opts ...CallOption) ([]byte, error)
+	GetAllVideoEnrollments(userId string, opts ...CallOption) ([]byte, error)
+	GetAllFaceEnrollments(userId string, opts ...CallOption) ([]byte, error)
+	CreateVoiceEnrollment(userId, contentLanguage, phrase, filePath string, opts ...CallOption) ([]byte, error)
+	CreateVoiceEnrollmentByByteSlice(userId, contentLanguage, phrase, filename string, fileData []byte, opts ...CallOption) ([]byte, error)
+	CreateVoiceEnrollmentByUrl(userId, contentLanguage, phrase, fileUrl string, opts ...CallOption) ([]byte, error)
+	CreateFaceEnrollment(userId, filePath string, isPhoto ...bool) ([]byte, error)
+	CreateFaceEnrollmentWithOptions(userId, filePath string, opts ...CallOption) ([]byte, error)
+	CreateFaceEnrollmentByByteSlice(userId, filename string, fileData []byte, isPhoto ...bool) ([]byte, error)
+	CreateFaceEnrollmentByByteSliceWithOptions(userId, filename string, fileData []byte, opts ...CallOption) ([]byte, error)
+	CreateFaceEnrollmentByUrl(userId, fileUrl string, opts ...CallOption) ([]byte, error)
+	CreateVideoEnrollment(userId, contentLanguage, phrase, filePath string, opts ...CallOption) ([]byte, error)
+	CreateVideoEnrollmentByByteSlice(userId, contentLanguage, phrase, filename string, fileData []byte, opts ...CallOption) ([]byte, error)
+	CreateSplitVideoEnrollment(userId, contentLanguage, phrase, audioFilePath, photoFilePath string, opts ...CallOption) ([]byte, error)
+	CreateSplitVideoEnrollmentByByteSlice(userId, contentLanguage, phrase, audioFilename, photoFilename string, audioFileData, photoFileData []byte, opts ...CallOption) ([]byte, error)
+	CreateVideoEnrollmentByUrl(userId, contentLanguage, phrase, fileUrl string, opts ...CallOption) ([]byte, error)
+	DeleteAllEnrollments(userId string, opts ...CallOption) ([]byte, error)
+	DeleteVoiceEnrollment(userId string, voiceEnrollmentId int, opts ...CallOption) ([]byte, error)
+	DeleteFaceEnrollment(userId string, faceEnrollmentId int, opts ...CallOption) ([]byte, error)
+	DeleteVideoEnrollment(userId string, videoEnrollmentId int, opts ...CallOption) ([]byte, error)
+}
+
+// Verification covers the verification endpoints
+type Verification interface {
+	VoiceVerification(userId, contentLanguage, phrase, filePath string, opts ...CallOption) ([]byte, error)
+	VoiceVerificationByByteSlice(userId, contentLanguage, phrase, filename string, fileData []byte, opts ...CallOption) ([]byte, error)
+	VoiceVerificationByUrl(userId, contentLanguage, phrase, fileUrl string, opts ...CallOption) ([]byte, error)
+	FaceVerification(userId, filePath string, isPhoto ...bool) ([]byte, error)
+	FaceVerificationWithOptions(userId, filePath string, opts ...CallOption) ([]byte, error)
+	FaceVerificationByByteSlice(userId, filename string, fileData []byte, isPhoto ...bool) ([]byte, error)
+	FaceVerificationByByteSliceWithOptions(userId, filename string, fileData []byte, opts ...CallOption) ([]byte, error)
+	FaceVerificationByUrl(userId, fileUrl string, opts ...CallOption) ([]byte, error)
+	VideoVerification(userId, contentLanguage, phrase, filePath string, opts ...CallOption) ([]byte, error)
+	VideoVerificationByByteSlice(userId, contentLanguage, phrase, filename string, fileData []byte, opts ...CallOption) ([]byte, error)
+	SplitVideoVerification(userId, contentLanguage, phrase, audioFilePath, photoFilePath string, opts ...CallOption) ([]byte, error)
+	SplitVideoVerificationByByteSlice(userId, contentLanguage, phrase, audioFilename, photoFilename string, audioFileData, photoFileData []byte, opts ...CallOption) ([]byte, error)
+	VideoVerificationByUrl(userId, contentLanguage, phrase, fileUrl string, opts ...CallOption) ([]byte, error)
+}
+
+// Identification covers the identification endpoints
+type Identification interface {
+	VoiceIdentification(groupId, contentLanguage, phrase, filePath string, opts ...CallOption) ([]byte, error)
+	VoiceIdentificationByByteSlice(groupId, contentLanguage, phrase, filename string, fileData []byte, opts ...CallOption) ([]byte, error)
+	VoiceIdentificationByUrl(groupId, contentLanguage, phrase, fileUrl string, opts ...CallOption) ([]byte, error)
+	VideoIdentification(groupId, contentLanguage, phrase, filePath string, opts ...CallOption) ([]byte, error)
+	VideoIdentificationByByteSlice(groupId, contentLanguage, phrase, filename string, fileData []byte, opts ...CallOption) ([]byte, error)
+	SplitVideoIdentification(groupId, contentLanguage, phrase, audioFilePath, photoFilePath string, opts ...CallOption) ([]byte, error)
+	SplitVideoIdentificationByByteSlice(groupId, contentLanguage, phrase, audioFilename, photoFilename string, audioFileData, photoFileData []byte, opts ...CallOption) ([]byte, error)
+	VideoIdentificationByUrl(groupId, contentLanguage, phrase, fileUrl string, opts ...CallOption) ([]byte, error)
+	FaceIdentification(groupId, filePath string, isPhoto ...bool) ([]byte, error)
+	FaceIdentificationWithOptions(groupId, filePath string, opts ...CallOption) ([]byte, error)
+	FaceIdentificationByByteSlice(groupId, filename string, fileData []byte, isPhoto ...bool) ([]byte, error)
+	FaceIdentificationByByteSliceWithOptions(groupId, filename string, fileData []byte, opts ...CallOption) ([]byte, error)
+	FaceIdentificationByUrl(groupId, fileUrl string, opts ...CallOption) ([]byte, error)
+}
+
+// SubAccounts covers the sub-account endpoints
+type SubAccounts interface {
+	CreateManagedSubAccount(params structs.CreateSubAccountRequest, opts ...CallOption) ([]byte, error)
+	CreateUnmanagedSubAccount(params structs.CreateSubAccountRequest, opts ...CallOption) ([]byte, error)
+	RegenerateSubAccountAPIToken(subAccountAPIKey string, opts ...CallOption) ([]byte, error)
+	DeleteSubAccount(subAccountAPIKey string, opts ...CallOption) ([]byte, error)
+	SwitchSubAccountType(subAccountAPIKey string, opts ...CallOption) ([]byte, error)
+}
+
+// Phrases covers the phrase endpoints
+type Phrases interface {
+	GetPhrases(contentLanguage string, opts ...CallOption) ([]byte, error)
+}
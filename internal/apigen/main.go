@@ -0,0 +1,571 @@
+// Command apigen generates the API client from spec/api.json: the VoiceIt2
+// methods and their endpoint table, the per-domain interfaces, the return
+// types of the structs package and the routes of the voiceit2test fake
+// server. Run it with go generate from the repository root
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const header = "// Code generated by internal/apigen from spec/api.json. DO NOT EDIT.\n\n"
+
+type Spec struct {
+	Interfaces []Interface `json:"interfaces"`
+	Endpoints  []Endpoint  `json:"endpoints"`
+	Structs    []Struct    `json:"structs"`
+}
+
+type Interface struct {
+	Name string `json:"name"`
+	Doc  string `json:"doc"`
+}
+
+// Endpoint is a single client method and the API call it makes
+type Endpoint struct {
+	Operation string `json:"operation"`
+	Interface string `json:"interface"`
+	Method    string `json:"method"`
+	// Path is the path template, with parameters in braces
+	Path string `json:"path"`
+	// Multipart sends an empty multipart body for calls without form fields
+	Multipart bool     `json:"multipart"`
+	Returns   string   `json:"returns"`
+	Params    []Param  `json:"params"`
+	Doc       []string `json:"doc"`
+}
+
+// Param is a parameter of a client method. In tells where it is sent:
+//
+//	path      a string or int substituted into the path template, escaped
+//	          and validated by the Validate method of the ID type, if any
+//	form      a multipart field, or one per entry of Fields for structs
+//	query     a query parameter, Encode "seconds" sends a time.Duration
+//	file      a multipart file read from the path it holds, or sent from the
+//	          []byte it holds with the name held by the Filename parameter
+//	filename  the name of a file sent from a []byte
+//	photoFlag variadic bool switching a file's field from Field to PhotoField
+//
+// Methods take CallOptions last, except those with a photoFlag, which keep
+// their signature and get an <Operation>WithOptions variant taking
+// CallOptions instead, AsPhoto replacing the photoFlag
+type Param struct {
+	Name       string       `json:"name"`
+	Type       string       `json:"type"`
+	In         string       `json:"in"`
+	Field      string       `json:"field"`
+	PhotoField string       `json:"photoField"`
+	Filename   string       `json:"filename"`
+	Encode     string       `json:"encode"`
+	Fields     []FieldValue `json:"fields"`
+	ID         string       `json:"id"`
+}
+
+type FieldValue struct {
+	Field string `json:"field"`
+	Value string `json:"value"`
+}
+
+// Struct is a type of the structs package, either with fields or an alias
+type Struct struct {
+	File   string        `json:"file"`
+	Name   string        `json:"name"`
+	Alias  string        `json:"alias"`
+	Fields []StructField `json:"fields"`
+}
+
+// StructField is a field of a Struct. A field without a name embeds its
+// type, such as the Envelope of every return struct
+type StructField struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	JSON string `json:"json"`
+}
+
+func (p Param) typ() string {
+	if p.Type == "" {
+		return "string"
+	}
+	return p.Type
+}
+
+func (p Param) field() string {
+	if p.Field == "" {
+		return p.Name
+	}
+	return p.Field
+}
+
+func main() {
+	specPath := flag.String("spec", "spec/api.json", "endpoint definition file")
+	root := flag.String("root", ".", "repository root to write the generated files to")
+	flag.Parse()
+
+	spec, err := loadSpec(*specPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	files, err := generate(spec)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for name, contents := range files {
+		if err := os.WriteFile(filepath.Join(*root, name), contents, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+func loadSpec(path string) (Spec, error) {
+	var spec Spec
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return spec, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(contents))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&spec); err != nil {
+		return spec, fmt.Errorf("%s: %w", path, err)
+	}
+	if err := validate(spec); err != nil {
+		return spec, fmt.Errorf("%s: %w", path, err)
+	}
+	return spec, nil
+}
+
+// generate returns the contents of every generated file by its path relative
+// to the repository root
+func generate(spec Spec) (map[string][]byte, error) {
+	sources := map[string]struct{ pkg, body string }{
+		"api_gen.go":                 {"voiceit2", genMethods(spec)},
+		"endpoints_gen.go":           {"voiceit2", genEndpoints(spec)},
+		"interfaces_gen.go":          {"voiceit2", genInterfaces(spec)},
+		"voiceit2test/routes_gen.go": {"voiceit2test", genRoutes(spec)},
+	}
+	for _, s := range spec.Structs {
+		sources["structs/"+s.File+"_gen.go"] = struct{ pkg, body string }{"structs", genStructs(spec, s.File)}
+	}
+
+	files := make(map[string][]byte)
+	for name, source := range sources {
+		contents, err := render(source.pkg, source.body)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		files[filepath.FromSlash(name)] = contents
+	}
+	return files, nil
+}
+
+// idTypes are the identifier types of the voiceit2 package
+var idTypes = map[string]bool{"UserID": true, "GroupID": true, "SubAccountKey": true}
+
+func validate(spec Spec) error {
+	interfaces := make(map[string]bool)
+	for _, i := range spec.Interfaces {
+		interfaces[i.Name] = true
+	}
+	structs := make(map[string]bool)
+	for _, s := range spec.Structs {
+		if structs[s.Name] {
+			return fmt.Errorf("struct %s is declared twice", s.Name)
+		}
+		structs[s.Name] = true
+		for _, f := range s.Fields {
+			if f.Name == "" && f.JSON != "" {
+				return fmt.Errorf("%s: embedded field %s cannot have a json name", s.Name, f.Type)
+			}
+		}
+	}
+	operations := make(map[string]bool)
+	for _, e := range spec.Endpoints {
+		if operations[e.Operation] {
+			return fmt.Errorf("operation %s is declared twice", e.Operation)
+		}
+		operations[e.Operation] = true
+		if !interfaces[e.Interface] {
+			return fmt.Errorf("%s: unknown interface %q", e.Operation, e.Interface)
+		}
+		if !structs[e.Returns] {
+			return fmt.Errorf("%s: unknown return struct %q", e.Operation, e.Returns)
+		}
+		params := make(map[string]Param)
+		for _, p := range e.Params {
+			params[p.Name] = p
+		}
+		for _, p := range e.Params {
+			switch p.In {
+			case "path":
+				if !strings.Contains(e.Path, "{"+p.Name+"}") {
+					return fmt.Errorf("%s: path parameter %s is not in %s", e.Operation, p.Name, e.Path)
+				}
+				if p.ID != "" && !idTypes[p.ID] {
+					return fmt.Errorf("%s: parameter %s has unknown id type %q", e.Operation, p.Name, p.ID)
+				}
+				if p.typ() != "string" && p.typ() != "int" {
+					return fmt.Errorf("%s: path parameter %s must be a string or an int", e.Operation, p.Name)
+				}
+			case "form", "query", "filename":
+			case "photoFlag":
+				if p.typ() != "...bool" || e.Params[len(e.Params)-1].Name != p.Name {
+					return fmt.Errorf("%s: photoFlag %s must be the last parameter and a ...bool", e.Operation, p.Name)
+				}
+			case "file":
+				if p.typ() == "[]byte" && params[p.Filename].In != "filename" {
+					return fmt.Errorf("%s: file %s has no filename parameter", e.Operation, p.Name)
+				}
+			default:
+				return fmt.Errorf("%s: parameter %s has unknown location %q", e.Operation, p.Name, p.In)
+			}
+		}
+		for _, segment := range strings.Split(e.Path, "/") {
+			if strings.HasPrefix(segment, "{") && params[strings.Trim(segment, "{}")].In != "path" {
+				return fmt.Errorf("%s: %s is not a path parameter", e.Operation, segment)
+			}
+		}
+	}
+	return nil
+}
+
+// render adds the imports body uses and formats it as a file of package pkg
+func render(pkg, body string) ([]byte, error) {
+	candidates := []string{
+		"bytes", "errors", "fmt", "io/ioutil", "mime/multipart", "net/http", "net/url", "path", "strconv", "time",
+		"github.com/voiceittech/VoiceIt2-Go/v2/structs",
+	}
+	var std, external []string
+	for _, imp := range candidates {
+		name := imp[strings.LastIndex(imp, "/")+1:]
+		if pkg == name || !regexp.MustCompile(`(^|[^\w.])`+name+`\.`).MatchString(body) {
+			continue
+		}
+		if strings.Contains(imp, ".") {
+			external = append(external, strconv.Quote(imp))
+		} else {
+			std = append(std, strconv.Quote(imp))
+		}
+	}
+
+	var src bytes.Buffer
+	src.WriteString(header)
+	fmt.Fprintf(&src, "package %s\n\n", pkg)
+	if len(std)+len(external) > 0 {
+		src.WriteString("import (\n")
+		for _, imp := range std {
+			fmt.Fprintf(&src, "\t%s\n", imp)
+		}
+		if len(std) > 0 && len(external) > 0 {
+			src.WriteString("\n")
+		}
+		for _, imp := range external {
+			fmt.Fprintf(&src, "\t%s\n", imp)
+		}
+		src.WriteString(")\n\n")
+	}
+	src.WriteString(body)
+
+	return format.Source(src.Bytes())
+}
+
+// signature renders the parameter list, grouping consecutive parameters
+// that share a type
+func signature(params []Param) string {
+	var parts []string
+	for i, p := range params {
+		if i+1 < len(params) && params[i+1].typ() == p.typ() {
+			parts = append(parts, p.Name)
+		} else {
+			parts = append(parts, p.Name+" "+p.typ())
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// photoFlag returns the photoFlag parameter of e, if any
+func (e Endpoint) photoFlag() (Param, bool) {
+	for _, p := range e.Params {
+		if p.In == "photoFlag" {
+			return p, true
+		}
+	}
+	return Param{}, false
+}
+
+// optionsMethod is the name of the method of e taking CallOptions
+func (e Endpoint) optionsMethod() string {
+	if _, ok := e.photoFlag(); ok {
+		return e.Operation + "WithOptions"
+	}
+	return e.Operation
+}
+
+// callParams are the parameters of e sent with the request
+func (e Endpoint) callParams() []Param {
+	var params []Param
+	for _, p := range e.Params {
+		if p.In != "photoFlag" {
+			params = append(params, p)
+		}
+	}
+	return params
+}
+
+// methodSignature is the parameter list of the method of e taking
+// CallOptions, which come last
+func methodSignature(e Endpoint) string {
+	params := e.callParams()
+	if len(params) == 0 {
+		return "opts ...CallOption"
+	}
+	return signature(params) + ", opts ...CallOption"
+}
+
+// pathExpr renders the path template as a string concatenation with its
+// parameters escaped, or formatted if they are ints
+func pathExpr(template string, params []Param) string {
+	types := make(map[string]string)
+	for _, p := range params {
+		types[p.Name] = p.typ()
+	}
+	var parts []string
+	for template != "" {
+		start := strings.Index(template, "{")
+		if start < 0 {
+			parts = append(parts, strconv.Quote(template))
+			break
+		}
+		if start > 0 {
+			parts = append(parts, strconv.Quote(template[:start]))
+		}
+		end := strings.Index(template, "}")
+		name := template[start+1 : end]
+		if types[name] == "int" {
+			parts = append(parts, "strconv.Itoa("+name+")")
+		} else {
+			parts = append(parts, "url.PathEscape("+name+")")
+		}
+		template = template[end+1:]
+	}
+	return strings.Join(parts, "+")
+}
+
+func genMethods(spec Spec) string {
+	var b strings.Builder
+	for _, e := range spec.Endpoints {
+		fail := func(indent string) string {
+			return fmt.Sprintf("%sreturn []byte{}, errors.New(%q + err.Error())\n", indent, e.Operation+" Exception: ")
+		}
+
+		for _, line := range e.Doc {
+			fmt.Fprintf(&b, "// %s\n", line)
+		}
+		if flag, ok := e.photoFlag(); ok {
+			var args []string
+			for _, p := range e.callParams() {
+				args = append(args, p.Name)
+			}
+			args = append(args, fmt.Sprintf("AsPhoto(len(%s) > 0 && %s[0])", flag.Name, flag.Name))
+			fmt.Fprintf(&b, "func (vi VoiceIt2) %s(%s) ([]byte, error) {\n\treturn vi.%s(%s)\n}\n\n", e.Operation, signature(e.Params), e.optionsMethod(), strings.Join(args, ", "))
+			fmt.Fprintf(&b, "// %s is %s taking CallOptions, with AsPhoto in place of\n// %s\n", e.optionsMethod(), e.Operation, flag.Name)
+		}
+		fmt.Fprintf(&b, "func (vi VoiceIt2) %s(%s) ([]byte, error) {\n", e.optionsMethod(), methodSignature(e))
+
+		var files, form, query []Param
+		for _, p := range e.Params {
+			switch p.In {
+			case "file":
+				files = append(files, p)
+			case "form":
+				form = append(form, p)
+			case "query":
+				query = append(query, p)
+			}
+		}
+		// Single files keep the short names, several are told apart by field
+		varName := func(p Param, suffix string) string {
+			if len(files) == 1 {
+				return strings.ToLower(suffix[:1]) + suffix[1:]
+			}
+			return p.field() + suffix
+		}
+
+		for _, p := range e.Params {
+			if p.In == "path" && p.ID != "" {
+				fmt.Fprintf(&b, "\tif err := %s(%s).Validate(); err != nil {\n\t\treturn []byte{}, fmt.Errorf(%q, err)\n\t}\n\n", p.ID, p.Name, e.Operation+" Exception: %w")
+			}
+		}
+
+		b.WriteString("\toptions := newCallOptions(opts)\n\n")
+
+		for _, f := range files {
+			if f.typ() == "string" {
+				fmt.Fprintf(&b, "\t%s, err := ioutil.ReadFile(%s)\n\tif err != nil {\n%s\t}\n\n", varName(f, "FileContents"), f.Name, fail("\t\t"))
+			}
+		}
+
+		multipartBody := e.Multipart || len(files)+len(form) > 0
+		if multipartBody {
+			b.WriteString("\tbody := &bytes.Buffer{}\n\twriter := multipart.NewWriter(body)\n\n")
+			for _, f := range files {
+				key := strconv.Quote(f.field())
+				if f.PhotoField != "" {
+					key = "fileFieldKey"
+					fmt.Fprintf(&b, "\tfileFieldKey := %q\n\tif options.photo {\n\t\tfileFieldKey = %q\n\t}\n\n", f.field(), f.PhotoField)
+				}
+				filename, contents := f.Filename, f.Name
+				if f.typ() == "string" {
+					filename, contents = "path.Base("+f.Name+")", varName(f, "FileContents")
+				}
+				part := varName(f, "Part")
+				fmt.Fprintf(&b, "\t%s, err := writer.CreateFormFile(%s, %s)\n\tif err != nil {\n%s\t}\n\n", part, key, filename, fail("\t\t"))
+				fmt.Fprintf(&b, "\tif _, err := %s.Write(%s); err != nil {\n%s\t}\n\n", part, contents, fail("\t\t"))
+			}
+			for _, p := range form {
+				fields := p.Fields
+				if len(fields) == 0 {
+					fields = []FieldValue{{Field: p.field()}}
+				}
+				for _, fv := range fields {
+					value := p.Name
+					if fv.Value != "" {
+						value += "." + fv.Value
+					}
+					fmt.Fprintf(&b, "\tif err := writer.WriteField(%q, %s); err != nil {\n%s\t}\n\n", fv.Field, value, fail("\t\t"))
+				}
+			}
+			b.WriteString("\twriter.Close()\n\n")
+		}
+
+		queryExpr := "nil"
+		if len(query) > 0 {
+			var values []string
+			for _, q := range query {
+				value := q.Name
+				if q.Encode == "seconds" {
+					value = "strconv.Itoa(int(" + q.Name + ".Seconds()))"
+				}
+				values = append(values, fmt.Sprintf("%q: {%s}", q.field(), value))
+			}
+			queryExpr = "url.Values{" + strings.Join(values, ", ") + "}"
+		}
+		bodyExpr := "nil"
+		if multipartBody {
+			bodyExpr = "body"
+		}
+		fmt.Fprintf(&b, "\treq, err := http.NewRequest(%q, vi.BaseUrl+%s+vi.query(options, %s), %s)\n\tif err != nil {\n%s\t}\n", e.Method, pathExpr(e.Path, e.Params), queryExpr, bodyExpr, fail("\t\t"))
+		if multipartBody {
+			b.WriteString("\treq.Header.Add(\"Content-Type\", writer.FormDataContentType())\n")
+		}
+		fmt.Fprintf(&b, "\n\treturn vi.do(vi.context(), %q, req, options)\n}\n\n", e.Operation)
+	}
+	return b.String()
+}
+
+func genEndpoints(spec Spec) string {
+	var b strings.Builder
+	b.WriteString("// operationEndpoints maps each operation to the path template of the endpoint\n")
+	b.WriteString("// it calls, so middleware can report endpoints without leaking the ids in\n")
+	b.WriteString("// the actual path\n")
+	b.WriteString("var operationEndpoints = map[string]string{\n")
+	for _, e := range spec.Endpoints {
+		fmt.Fprintf(&b, "\t%q: %q,\n", e.Operation, e.Path)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func genInterfaces(spec Spec) string {
+	var b strings.Builder
+	for _, i := range spec.Interfaces {
+		fmt.Fprintf(&b, "// %s\ntype %s interface {\n", i.Doc, i.Name)
+		for _, e := range spec.Endpoints {
+			if e.Interface != i.Name {
+				continue
+			}
+			if _, ok := e.photoFlag(); ok {
+				fmt.Fprintf(&b, "\t%s(%s) ([]byte, error)\n", e.Operation, signature(e.Params))
+			}
+			fmt.Fprintf(&b, "\t%s(%s) ([]byte, error)\n", e.optionsMethod(), methodSignature(e))
+		}
+		b.WriteString("}\n\n")
+	}
+	return b.String()
+}
+
+func genStructs(spec Spec, file string) string {
+	var b strings.Builder
+	for _, s := range spec.Structs {
+		if s.File != file {
+			continue
+		}
+		if s.Alias != "" {
+			fmt.Fprintf(&b, "// %s is the same type as %s\ntype %s = %s\n\n", s.Name, s.Alias, s.Name, s.Alias)
+			continue
+		}
+		fmt.Fprintf(&b, "type %s struct {\n", s.Name)
+		for _, f := range s.Fields {
+			if f.Name == "" {
+				fmt.Fprintf(&b, "\t%s\n", f.Type)
+				continue
+			}
+			fmt.Fprintf(&b, "\t%s %s `json:%q`\n", f.Name, f.Type, f.JSON)
+		}
+		b.WriteString("}\n\n")
+	}
+	return b.String()
+}
+
+func genRoutes(spec Spec) string {
+	hasFields := make(map[string]map[string]bool)
+	for _, s := range spec.Structs {
+		fields := make(map[string]bool)
+		for _, f := range s.Fields {
+			if f.Name == "" {
+				fields[f.Type] = true
+			}
+			fields[f.Name] = true
+		}
+		hasFields[s.Name] = fields
+	}
+
+	var b strings.Builder
+	b.WriteString("// routes lists the endpoint of every operation\n")
+	b.WriteString("var routes = []route{\n")
+	for _, e := range spec.Endpoints {
+		fmt.Fprintf(&b, "\t{method: %q, path: %q, operation: %q},\n", e.Method, e.Path, e.Operation)
+	}
+	b.WriteString("}\n\n")
+
+	b.WriteString("// defaultResponse returns the response served for operation when no\n")
+	b.WriteString("// handler is registered: a successful, otherwise empty, return struct\n")
+	b.WriteString("func defaultResponse(operation string) interface{} {\n\tswitch operation {\n")
+	byReturn := make(map[string][]string)
+	var returns []string
+	for _, e := range spec.Endpoints {
+		if byReturn[e.Returns] == nil {
+			returns = append(returns, e.Returns)
+		}
+		byReturn[e.Returns] = append(byReturn[e.Returns], strconv.Quote(e.Operation))
+	}
+	sort.Strings(returns)
+	for _, r := range returns {
+		var fields []string
+		if hasFields[r]["Envelope"] {
+			fields = append(fields, "Envelope: structs.Envelope{Status: 200, ResponseCode: \"SUCC\"}")
+		}
+		fmt.Fprintf(&b, "\tcase %s:\n\t\treturn &structs.%s{%s}\n", strings.Join(byReturn[r], ", "), r, strings.Join(fields, ", "))
+	}
+	b.WriteString("\t}\n\treturn nil\n}\n")
+	return b.String()
+}
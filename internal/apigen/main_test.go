@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	assert := assert.New(t)
+
+	spec, err := loadSpec("../../spec/api.json")
+	if !assert.Equal(nil, err) {
+		return
+	}
+	files, err := generate(spec)
+	if !assert.Equal(nil, err) {
+		return
+	}
+	for name, want := range files {
+		got, err := os.ReadFile(filepath.Join("../..", name))
+		assert.Equal(nil, err)
+		assert.True(bytes.Equal(want, got), name+" is out of date, run go generate")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	valid := func() Spec {
+		return Spec{
+			Interfaces: []Interface{{Name: "Users"}},
+			Structs:    []Struct{{Name: "GetUserReturn"}},
+			Endpoints: []Endpoint{{
+				Operation: "GetUser",
+				Interface: "Users",
+				Method:    "GET",
+				Path:      "/users/{userId}",
+				Returns:   "GetUserReturn",
+				Params:    []Param{{Name: "userId", In: "path"}},
+			}},
+		}
+	}
+	assert.Equal(nil, validate(valid()))
+
+	spec := valid()
+	spec.Endpoints[0].Params[0].In = "form"
+	assert.NotEqual(nil, validate(spec), "path templates need a matching path parameter")
+
+	spec = valid()
+	spec.Endpoints[0].Returns = "UserReturn"
+	assert.NotEqual(nil, validate(spec))
+
+	spec = valid()
+	spec.Endpoints = append(spec.Endpoints, spec.Endpoints[0])
+	assert.NotEqual(nil, validate(spec))
+
+	spec = valid()
+	spec.Endpoints[0].Params = append(spec.Endpoints[0].Params, Param{Name: "fileData", Type: "[]byte", In: "file"})
+	assert.NotEqual(nil, validate(spec), "files sent from bytes need a filename parameter")
+}
@@ -0,0 +1,205 @@
+// Command mockgen generates the voiceit2mock package from the interfaces
+// declared in client.go. Run it with go generate from the repository root
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type method struct {
+	name     string
+	funcType string
+	params   string
+	args     []string
+	variadic bool
+	// options is set if the last parameter holds the CallOptions, which are
+	// not recorded
+	options bool
+}
+
+func main() {
+	in := flag.String("in", "client.go", "comma separated files declaring the interfaces to mock")
+	out := flag.String("out", "voiceit2mock/mock.go", "file to write the mock to")
+	flag.Parse()
+
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, name := range strings.Split(*in, ",") {
+		file, err := parser.ParseFile(fset, name, nil, 0)
+		if err != nil {
+			log.Fatal(err)
+		}
+		files = append(files, file)
+	}
+
+	render := func(node ast.Node) string {
+		var buf bytes.Buffer
+		printer.Fprint(&buf, fset, node)
+		return buf.String()
+	}
+
+	var methods []method
+	var imports []*ast.ImportSpec
+	for _, file := range files {
+		imports = append(imports, file.Imports...)
+	}
+	for _, decl := range declarations(files) {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			iface, ok := spec.(*ast.TypeSpec).Type.(*ast.InterfaceType)
+			if !ok {
+				continue
+			}
+			for _, field := range iface.Methods.List {
+				// Embedded interfaces are declared in the same file
+				if len(field.Names) == 0 {
+					continue
+				}
+				fn := field.Type.(*ast.FuncType)
+				qualify(fn)
+				methods = append(methods, newMethod(field.Names[0].Name, fn, render))
+			}
+		}
+	}
+
+	var body bytes.Buffer
+	fmt.Fprintf(&body, "// Client is a mock voiceit2.Client. Every call is recorded, see Calls, and\n")
+	fmt.Fprintf(&body, "// answered by the method's Func field if it is set, or else by the responses\n")
+	fmt.Fprintf(&body, "// queued with On\n")
+	fmt.Fprintf(&body, "type Client struct {\n\trecorder\n\n")
+	for _, m := range methods {
+		fmt.Fprintf(&body, "\t%sFunc %s\n", m.name, m.funcType)
+	}
+	fmt.Fprintf(&body, "}\n\nvar _ voiceit2.Client = (*Client)(nil)\n")
+	for _, m := range methods {
+		call := strings.Join(m.args, ", ")
+		if m.variadic {
+			call += "..."
+		}
+		recorded := m.args
+		if m.options {
+			recorded = recorded[:len(recorded)-1]
+		}
+		record := strconv.Quote(m.name)
+		if len(recorded) > 0 {
+			record += ", " + strings.Join(recorded, ", ")
+		}
+		fmt.Fprintf(&body, "\nfunc (m *Client) %s(%s) ([]byte, error) {\n", m.name, m.params)
+		fmt.Fprintf(&body, "\tm.record(%s)\n", record)
+		fmt.Fprintf(&body, "\tif m.%sFunc != nil {\n\t\treturn m.%sFunc(%s)\n\t}\n", m.name, m.name, call)
+		fmt.Fprintf(&body, "\treturn m.response(%q)\n}\n", m.name)
+	}
+
+	var src bytes.Buffer
+	fmt.Fprintf(&src, "// Code generated by internal/mockgen from %s. DO NOT EDIT.\n\n", strings.Join(strings.Split(*in, ","), ", "))
+	std, external := []string{}, []string{strconv.Quote("github.com/voiceittech/VoiceIt2-Go/v2")}
+	seen := make(map[string]bool)
+	for _, imp := range imports {
+		importPath, _ := strconv.Unquote(imp.Path.Value)
+		if seen[importPath] || !strings.Contains(body.String(), path.Base(importPath)+".") {
+			continue
+		}
+		seen[importPath] = true
+		if strings.Contains(strings.Split(importPath, "/")[0], ".") {
+			external = append(external, imp.Path.Value)
+		} else {
+			std = append(std, imp.Path.Value)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(external)
+	fmt.Fprintf(&src, "package %s\n\nimport (\n", path.Base(path.Dir(*out)))
+	for _, imp := range std {
+		fmt.Fprintf(&src, "\t%s\n", imp)
+	}
+	if len(std) > 0 {
+		fmt.Fprintf(&src, "\n")
+	}
+	for _, imp := range external {
+		fmt.Fprintf(&src, "\t%s\n", imp)
+	}
+	fmt.Fprintf(&src, ")\n\n")
+	src.Write(body.Bytes())
+
+	formatted, err := format.Source(src.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, formatted, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func declarations(files []*ast.File) []ast.Decl {
+	var decls []ast.Decl
+	for _, file := range files {
+		decls = append(decls, file.Decls...)
+	}
+	return decls
+}
+
+// qualify makes the types of the voiceit2 package used by fn, such as
+// CallOption, refer to it from the mock's package
+func qualify(fn *ast.FuncType) {
+	for _, list := range []*ast.FieldList{fn.Params, fn.Results} {
+		if list == nil {
+			continue
+		}
+		for _, field := range list.List {
+			field.Type = qualifyExpr(field.Type)
+		}
+	}
+}
+
+func qualifyExpr(expr ast.Expr) ast.Expr {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if e.IsExported() {
+			return &ast.SelectorExpr{X: ast.NewIdent("voiceit2"), Sel: e}
+		}
+	case *ast.Ellipsis:
+		e.Elt = qualifyExpr(e.Elt)
+	case *ast.StarExpr:
+		e.X = qualifyExpr(e.X)
+	case *ast.ArrayType:
+		e.Elt = qualifyExpr(e.Elt)
+	case *ast.MapType:
+		e.Key, e.Value = qualifyExpr(e.Key), qualifyExpr(e.Value)
+	}
+	return expr
+}
+
+func newMethod(name string, fn *ast.FuncType, render func(ast.Node) string) method {
+	m := method{name: name, funcType: render(fn)}
+	var params []string
+	for i, field := range fn.Params.List {
+		names := field.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{ast.NewIdent("arg" + strconv.Itoa(i))}
+		}
+		for _, ident := range names {
+			m.args = append(m.args, ident.Name)
+			params = append(params, ident.Name+" "+render(field.Type))
+		}
+		_, m.variadic = field.Type.(*ast.Ellipsis)
+		m.options = m.variadic && render(field.Type) == "...voiceit2.CallOption"
+	}
+	m.params = strings.Join(params, ", ")
+	return m
+}
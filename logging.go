@@ -0,0 +1,104 @@
+package voiceit2
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// WithLogger logs every API call made by the client to logger. Only the
+// operation, endpoint template, HTTP status, responseCode, apiCallId, latency
+// and payload sizes are logged. Request and response bodies, headers and the
+// ids in the request path never are, so credentials, user tokens, sub-account
+// passwords and media stay out of the logs
+func WithLogger(logger *slog.Logger) Option {
+	return WithMiddleware(loggingMiddleware(logger))
+}
+
+func loggingMiddleware(logger *slog.Logger) Middleware {
+	return func(next RoundTrip) RoundTrip {
+		return func(ctx context.Context, op string, req *http.Request) (*Response, error) {
+			start := time.Now()
+			resp, err := next(ctx, op, req)
+
+			attrs := []slog.Attr{
+				slog.String("operation", op),
+				slog.String("method", req.Method),
+				slog.String("endpoint", endpointFor(op)),
+				slog.Duration("latency", time.Since(start)),
+				slog.Int64("requestBytes", req.ContentLength),
+			}
+			if err != nil {
+				attrs = append(attrs, slog.String("error", redactError(err)))
+				logger.LogAttrs(ctx, slog.LevelError, "VoiceIt API call failed", attrs...)
+				return resp, err
+			}
+
+			attrs = append(attrs,
+				slog.Int("httpStatus", resp.StatusCode),
+				slog.String("responseCode", resp.ResponseCode),
+				slog.String("apiCallId", resp.APICallId),
+				slog.Int("responseBytes", len(resp.Body)),
+			)
+			level := slog.LevelInfo
+			if resp.StatusCode >= 500 {
+				level = slog.LevelError
+			} else if resp.StatusCode >= 400 {
+				level = slog.LevelWarn
+			}
+			logger.LogAttrs(ctx, level, "VoiceIt API call", attrs...)
+			return resp, err
+		}
+	}
+}
+
+// redactError returns the error message without the request URL, which may
+// contain ids and notification URLs
+func redactError(err error) string {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return urlErr.Op + ": " + urlErr.Err.Error()
+	}
+	return err.Error()
+}
+
+// redact keeps only the prefix that identifies the kind of a credential,
+// e.g. "key_" or "utk_"
+func redact(secret string) string {
+	if len(secret) <= 4 {
+		return "[REDACTED]"
+	}
+	return secret[:4] + "[REDACTED]"
+}
+
+// LogValue keeps the client's credentials out of slog output
+func (vi VoiceIt2) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("baseUrl", vi.BaseUrl),
+		slog.String("apiKey", redact(vi.APIKey)),
+	)
+}
+
+// LogValue keeps the API token out of slog output
+func (sc StaticCredentials) LogValue() slog.Value {
+	return slog.GroupValue(slog.String("apiKey", redact(sc.APIKey)))
+}
+
+// LogValue keeps the API token out of slog output
+func (tc TenantCredentials) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("apiKey", redact(tc.APIKey)),
+		slog.String("type", tc.Type),
+	)
+}
+
+// LogValue keeps the user token out of slog output
+func (ter TokenExchangeResponse) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("userId", ter.UserId),
+		slog.Int64("expiresAt", ter.ExpiresAt),
+	)
+}
@@ -0,0 +1,53 @@
+package voiceit2
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/voiceittech/VoiceIt2-Go/v2/structs"
+)
+
+func TestLoggerRedaction(t *testing.T) {
+	assert := assert.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/token"):
+			w.Write([]byte(`{"status":201,"responseCode":"SUCC","apiCallId":"api_token","userToken":"utk_secretusertoken"}`))
+		case r.URL.Path == "/subaccount/managed":
+			w.Write([]byte(`{"status":201,"responseCode":"SUCC","apiCallId":"api_sub","apiToken":"tok_secretsubtoken","password":"hunter2"}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"status":400,"responseCode":"FAIL","apiCallId":"api_verify"}`))
+		}
+	}))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&logs, nil))
+	myVoiceIt := NewClientWithOptions("key_secretkey", "tok_secretmastertoken", WithBaseUrl(server.URL), WithLogger(logger))
+
+	ret, err := myVoiceIt.CreateUserToken("usr_1", 0)
+	assert.Equal(nil, err)
+	assert.Contains(string(ret), "utk_secretusertoken")
+	myVoiceIt.CreateManagedSubAccount(structs.CreateSubAccountRequest{Password: "hunter2"})
+	myVoiceIt.FaceVerificationByByteSlice("usr_1", "face.jpg", []byte("RAWMEDIABYTES"), true)
+
+	logger.Info("client", "client", myVoiceIt, "request", structs.CreateSubAccountRequest{Password: "hunter2"})
+
+	out := logs.String()
+	for _, secret := range []string{"tok_secretmastertoken", "secretkey", "utk_secretusertoken", "tok_secretsubtoken", "hunter2", "RAWMEDIABYTES", "Authorization", "usr_1"} {
+		assert.NotContains(out, secret)
+	}
+	assert.Contains(out, `"operation":"CreateUserToken"`)
+	assert.Contains(out, `"endpoint":"/users/{userId}/token"`)
+	assert.Contains(out, `"apiCallId":"api_token"`)
+	assert.Contains(out, `"responseCode":"FAIL"`)
+	assert.Contains(out, `"httpStatus":400`)
+	assert.Contains(out, `"level":"WARN"`)
+}
@@ -0,0 +1,208 @@
+package voiceit2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Metrics receives measurements for every API call made by a client
+type Metrics interface {
+	// ObserveCall is called once per API call. httpStatus and responseCode
+	// are empty when err is not nil
+	ObserveCall(op, endpoint string, httpStatus int, responseCode string, latency time.Duration, err error)
+	// ObserveConfidence is called for every confidence score returned by a
+	// verification or identification, where kind is the name of the
+	// response field: "confidence", "faceConfidence" or "voiceConfidence"
+	ObserveConfidence(op, kind string, confidence float64)
+}
+
+// WithMetrics reports every API call made by the client to m
+func WithMetrics(m Metrics) Option {
+	return WithMiddleware(metricsMiddleware(m))
+}
+
+type confidenceFields struct {
+	Confidence      *float64 `json:"confidence"`
+	FaceConfidence  *float64 `json:"faceConfidence"`
+	VoiceConfidence *float64 `json:"voiceConfidence"`
+}
+
+func metricsMiddleware(m Metrics) Middleware {
+	return func(next RoundTrip) RoundTrip {
+		return func(ctx context.Context, op string, req *http.Request) (*Response, error) {
+			start := time.Now()
+			resp, err := next(ctx, op, req)
+			endpoint := endpointFor(op)
+			if err != nil {
+				m.ObserveCall(op, endpoint, 0, "", time.Since(start), err)
+				return resp, err
+			}
+			m.ObserveCall(op, endpoint, resp.StatusCode, resp.ResponseCode, time.Since(start), nil)
+
+			if strings.HasPrefix(endpoint, "/verification/") || strings.HasPrefix(endpoint, "/identification/") {
+				var cf confidenceFields
+				if json.Unmarshal(resp.Body, &cf) == nil {
+					if cf.Confidence != nil {
+						m.ObserveConfidence(op, "confidence", *cf.Confidence)
+					}
+					if cf.FaceConfidence != nil {
+						m.ObserveConfidence(op, "faceConfidence", *cf.FaceConfidence)
+					}
+					if cf.VoiceConfidence != nil {
+						m.ObserveConfidence(op, "voiceConfidence", *cf.VoiceConfidence)
+					}
+				}
+			}
+			return resp, err
+		}
+	}
+}
+
+var (
+	// DefaultLatencyBuckets are the upper bounds, in seconds, of the latency
+	// histogram kept by PrometheusMetrics
+	DefaultLatencyBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+	// DefaultConfidenceBuckets are the upper bounds of the confidence
+	// histogram kept by PrometheusMetrics. Confidences range from 0 to 100
+	DefaultConfidenceBuckets = []float64{10, 20, 30, 40, 50, 60, 70, 80, 85, 90, 95, 100}
+)
+
+// PrometheusMetrics is a Metrics implementation that keeps counters and
+// histograms in memory and writes them in the Prometheus text exposition
+// format, either through WriteTo or by serving them over HTTP
+type PrometheusMetrics struct {
+	mu          sync.Mutex
+	calls       map[string]uint64
+	errors      map[string]uint64
+	latency     map[string]*histogram
+	confidences map[string]*histogram
+}
+
+type histogram struct {
+	bounds []float64
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+func (h *histogram) observe(v float64) {
+	for i, bound := range h.bounds {
+		if v <= bound {
+			h.counts[i]++
+		}
+	}
+	h.sum += v
+	h.count++
+}
+
+// NewPrometheusMetrics returns an empty PrometheusMetrics
+func NewPrometheusMetrics() *PrometheusMetrics {
+	return &PrometheusMetrics{
+		calls:       make(map[string]uint64),
+		errors:      make(map[string]uint64),
+		latency:     make(map[string]*histogram),
+		confidences: make(map[string]*histogram),
+	}
+}
+
+func (pm *PrometheusMetrics) ObserveCall(op, endpoint string, httpStatus int, responseCode string, latency time.Duration, err error) {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+	labels := promLabels("operation", op, "endpoint", endpoint)
+	if err != nil {
+		pm.errors[labels]++
+	} else {
+		pm.calls[promLabels("operation", op, "endpoint", endpoint, "http_status", strconv.Itoa(httpStatus), "response_code", responseCode)]++
+	}
+	h, ok := pm.latency[labels]
+	if !ok {
+		h = &histogram{bounds: DefaultLatencyBuckets, counts: make([]uint64, len(DefaultLatencyBuckets))}
+		pm.latency[labels] = h
+	}
+	h.observe(latency.Seconds())
+}
+
+func (pm *PrometheusMetrics) ObserveConfidence(op, kind string, confidence float64) {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+	labels := promLabels("operation", op, "kind", kind)
+	h, ok := pm.confidences[labels]
+	if !ok {
+		h = &histogram{bounds: DefaultConfidenceBuckets, counts: make([]uint64, len(DefaultConfidenceBuckets))}
+		pm.confidences[labels] = h
+	}
+	h.observe(confidence)
+}
+
+// WriteTo writes all metrics to w in the Prometheus text exposition format
+func (pm *PrometheusMetrics) WriteTo(w io.Writer) (int64, error) {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+
+	var sb strings.Builder
+	writePromCounter(&sb, "voiceit_api_calls_total", "VoiceIt API calls that received a response.", pm.calls)
+	writePromCounter(&sb, "voiceit_api_errors_total", "VoiceIt API calls that failed without a response.", pm.errors)
+	writePromHistogram(&sb, "voiceit_api_latency_seconds", "VoiceIt API call latency in seconds.", pm.latency)
+	writePromHistogram(&sb, "voiceit_confidence", "Confidence scores returned by verifications and identifications.", pm.confidences)
+	n, err := io.WriteString(w, sb.String())
+	return int64(n), err
+}
+
+func (pm *PrometheusMetrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	pm.WriteTo(w)
+}
+
+func writePromCounter(sb *strings.Builder, name, help string, values map[string]uint64) {
+	fmt.Fprintf(sb, "# HELP %s %s\n# TYPE %s counter\n", name, help, name)
+	for _, labels := range sortedKeys(values) {
+		fmt.Fprintf(sb, "%s{%s} %d\n", name, labels, values[labels])
+	}
+}
+
+func writePromHistogram(sb *strings.Builder, name, help string, values map[string]*histogram) {
+	fmt.Fprintf(sb, "# HELP %s %s\n# TYPE %s histogram\n", name, help, name)
+	for _, labels := range sortedKeys(values) {
+		h := values[labels]
+		for i, bound := range h.bounds {
+			fmt.Fprintf(sb, "%s_bucket{%s,le=\"%s\"} %d\n", name, labels, strconv.FormatFloat(bound, 'g', -1, 64), h.counts[i])
+		}
+		fmt.Fprintf(sb, "%s_bucket{%s,le=\"+Inf\"} %d\n", name, labels, h.count)
+		fmt.Fprintf(sb, "%s_sum{%s} %s\n", name, labels, strconv.FormatFloat(h.sum, 'g', -1, 64))
+		fmt.Fprintf(sb, "%s_count{%s} %d\n", name, labels, h.count)
+	}
+}
+
+// promLabels formats name/value pairs as a Prometheus label set
+func promLabels(pairs ...string) string {
+	var sb strings.Builder
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(pairs[i])
+		sb.WriteString(`="`)
+		sb.WriteString(promEscaper.Replace(pairs[i+1]))
+		sb.WriteByte('"')
+	}
+	return sb.String()
+}
+
+var promEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,50 @@
+package voiceit2
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrometheusMetrics(t *testing.T) {
+	assert := assert.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/verification/video" {
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC","voiceConfidence":92.5,"faceConfidence":99.1}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"status":404,"responseCode":"UNFD"}`))
+	}))
+
+	metrics := NewPrometheusMetrics()
+	myVoiceIt := NewClientWithOptions("key", "tok", WithBaseUrl(server.URL), WithMetrics(metrics))
+	myVoiceIt.VideoVerificationByByteSlice("usr_1", "en-US", "phrase", "video.mp4", []byte("video"))
+	myVoiceIt.CheckUserExists("usr_1")
+	myVoiceIt.CheckUserExists("usr_1")
+	server.Close()
+	_, err := myVoiceIt.GetAllUsers()
+	assert.NotEqual(nil, err)
+
+	var sb strings.Builder
+	_, err = metrics.WriteTo(&sb)
+	assert.Equal(nil, err)
+	out := sb.String()
+
+	assert.Contains(out, `voiceit_api_calls_total{operation="CheckUserExists",endpoint="/users/{userId}",http_status="404",response_code="UNFD"} 2`)
+	assert.Contains(out, `voiceit_api_calls_total{operation="VideoVerificationByByteSlice",endpoint="/verification/video",http_status="200",response_code="SUCC"} 1`)
+	assert.Contains(out, `voiceit_api_errors_total{operation="GetAllUsers",endpoint="/users"} 1`)
+	assert.Contains(out, `voiceit_api_latency_seconds_count{operation="CheckUserExists",endpoint="/users/{userId}"} 2`)
+	assert.Contains(out, `voiceit_confidence_bucket{operation="VideoVerificationByByteSlice",kind="voiceConfidence",le="90"} 0`)
+	assert.Contains(out, `voiceit_confidence_bucket{operation="VideoVerificationByByteSlice",kind="voiceConfidence",le="95"} 1`)
+	assert.Contains(out, `voiceit_confidence_sum{operation="VideoVerificationByByteSlice",kind="faceConfidence"} 99.1`)
+	assert.NotContains(out, `kind="confidence"`)
+
+	rec := httptest.NewRecorder()
+	metrics.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	assert.Equal(out, rec.Body.String())
+}
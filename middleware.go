@@ -0,0 +1,118 @@
+package voiceit2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/voiceittech/VoiceIt2-Go/v2/structs"
+)
+
+// Response is an API response as seen by middleware. Besides the raw body it
+// carries the fields every VoiceIt response shares, decoded from the body
+// when it is JSON
+type Response struct {
+	StatusCode int         `json:"-"`
+	Header     http.Header `json:"-"`
+	Body       []byte      `json:"-"`
+
+	structs.Envelope
+}
+
+// RoundTrip sends req for the named operation, e.g. "VideoIdentification",
+// and returns its response
+type RoundTrip func(ctx context.Context, op string, req *http.Request) (*Response, error)
+
+// Middleware wraps a RoundTrip to add behavior around every API call. It may
+// modify the request before calling next, inspect or replace the response,
+// or return an error without calling next at all
+type Middleware func(next RoundTrip) RoundTrip
+
+var defaultHTTPClient = &http.Client{}
+
+type clientKey struct{}
+
+// clientFromContext returns the client making the call whose middleware is
+// given ctx, so middleware can make further calls through the same client
+func clientFromContext(ctx context.Context) (VoiceIt2, bool) {
+	vi, ok := ctx.Value(clientKey{}).(VoiceIt2)
+	return vi, ok
+}
+
+// context returns the context API calls are made with
+func (vi VoiceIt2) context() context.Context {
+	if vi.ctx == nil {
+		return context.Background()
+	}
+	return vi.ctx
+}
+
+// do authenticates req, sends it through the client's middleware and returns
+// the response body. Every API call goes through do
+func (vi VoiceIt2) do(ctx context.Context, op string, req *http.Request, options callOptions) ([]byte, error) {
+	if options.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.timeout)
+		defer cancel()
+	}
+	req = req.WithContext(ctx)
+	for key, values := range options.header {
+		req.Header[http.CanonicalHeaderKey(key)] = values
+	}
+	if err := vi.setAuth(req); err != nil {
+		return []byte{}, fmt.Errorf("%s Exception: %w", op, err)
+	}
+	req.Header.Set("platformId", PlatformId)
+	req.Header.Set("platformVersion", PlatformVersion)
+
+	roundTrip := vi.send
+	for i := len(vi.middleware) - 1; i >= 0; i-- {
+		roundTrip = requireResponse(vi.middleware[i](roundTrip))
+	}
+
+	resp, err := roundTrip(context.WithValue(ctx, clientKey{}, vi), op, req)
+	if err != nil {
+		return []byte{}, fmt.Errorf("%s Exception: %w", op, err)
+	}
+	return resp.Body, nil
+}
+
+// requireResponse turns a middleware returning neither a response nor an
+// error into a failed call, so the middleware around it never sees a nil
+// response without an error
+func requireResponse(next RoundTrip) RoundTrip {
+	return func(ctx context.Context, op string, req *http.Request) (*Response, error) {
+		resp, err := next(ctx, op, req)
+		if err == nil && resp == nil {
+			return nil, errors.New("middleware returned no response")
+		}
+		return resp, err
+	}
+}
+
+// send is the innermost RoundTrip, which performs the HTTP request
+func (vi VoiceIt2) send(ctx context.Context, op string, req *http.Request) (*Response, error) {
+	client := vi.HTTPClient
+	if client == nil {
+		client = defaultHTTPClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	reply, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &Response{}
+	json.Unmarshal(reply, response)
+	response.StatusCode = resp.StatusCode
+	response.Header = resp.Header
+	response.Body = reply
+	return response, nil
+}
@@ -0,0 +1,72 @@
+package voiceit2
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMiddleware(t *testing.T) {
+	assert := assert.New(t)
+
+	var traceHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceHeader = r.Header.Get("X-Trace")
+		w.Write([]byte(`{"status":200,"responseCode":"SUCC","apiCallId":"api_1"}`))
+	}))
+	defer server.Close()
+
+	var calls []string
+	record := func(name string) Middleware {
+		return func(next RoundTrip) RoundTrip {
+			return func(ctx context.Context, op string, req *http.Request) (*Response, error) {
+				calls = append(calls, name+" "+op)
+				req.Header.Set("X-Trace", name)
+				resp, err := next(ctx, op, req)
+				if err == nil {
+					calls = append(calls, name+" "+resp.ResponseCode+" "+resp.APICallId)
+				}
+				return resp, err
+			}
+		}
+	}
+
+	myVoiceIt := NewClientWithOptions("key", "tok", WithBaseUrl(server.URL), WithMiddleware(record("outer"), record("inner")))
+	ret, err := myVoiceIt.CheckGroupExists("grp_1")
+	assert.Equal(nil, err)
+	assert.Contains(string(ret), "api_1")
+	assert.Equal([]string{"outer CheckGroupExists", "inner CheckGroupExists", "inner SUCC api_1", "outer SUCC api_1"}, calls)
+	assert.Equal("inner", traceHeader, "inner middleware should see and modify the request last")
+
+	errDenied := errors.New("denied")
+	deny := func(next RoundTrip) RoundTrip {
+		return func(ctx context.Context, op string, req *http.Request) (*Response, error) {
+			return nil, errDenied
+		}
+	}
+	_, err = myVoiceIt.With(WithMiddleware(deny)).GetAllUsers()
+	assert.True(errors.Is(err, errDenied))
+	assert.True(strings.HasPrefix(err.Error(), "GetAllUsers Exception: "))
+
+	empty := func(next RoundTrip) RoundTrip {
+		return func(ctx context.Context, op string, req *http.Request) (*Response, error) {
+			return nil, nil
+		}
+	}
+	_, err = myVoiceIt.With(WithMiddleware(empty)).GetAllUsers()
+	assert.Error(err, "a middleware returning no response should fail the call, not crash it")
+
+	calls = nil
+	myVoiceIt.GetAllUsers()
+	assert.Equal(4, len(calls), "With should not change the original client")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = myVoiceIt.WithContext(ctx).GetAllUsers()
+	assert.True(errors.Is(err, context.Canceled))
+}
@@ -0,0 +1,163 @@
+package voiceit2
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/voiceittech/VoiceIt2-Go/v2/structs"
+)
+
+// PhraseCache wraps GetPhrases with a concurrency safe, per content language
+// cache. Concurrent misses for the same content language share a single API
+// call, and only successful responses are cached
+type PhraseCache struct {
+	vi  Phrases
+	ttl time.Duration
+
+	mu         sync.RWMutex
+	entries    map[string]phraseCacheEntry
+	generation int
+	flight     flightGroup
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	stop      chan struct{}
+}
+
+type phraseCacheEntry struct {
+	reply   []byte
+	expires time.Time
+}
+
+// NewPhraseCache returns a PhraseCache that serves GetPhrases responses from
+// the given client for up to ttl before fetching them again
+func NewPhraseCache(vi Phrases, ttl time.Duration) *PhraseCache {
+	return &PhraseCache{
+		vi:      vi,
+		ttl:     ttl,
+		entries: make(map[string]phraseCacheEntry),
+		stop:    make(chan struct{}),
+	}
+}
+
+// GetPhrases returns the same response as VoiceIt2.GetPhrases for the given
+// contentLanguage, calling the API only when no fresh response is cached
+func (pc *PhraseCache) GetPhrases(contentLanguage string) ([]byte, error) {
+	pc.mu.RLock()
+	entry, ok := pc.entries[contentLanguage]
+	pc.mu.RUnlock()
+	if ok && time.Now().Before(entry.expires) {
+		// Callers must not be able to change the cached reply
+		return append([]byte(nil), entry.reply...), nil
+	}
+	return pc.fetch(contentLanguage)
+}
+
+// Phrases returns the decoded list of phrases for the given contentLanguage
+func (pc *PhraseCache) Phrases(contentLanguage string) ([]structs.Phrase, error) {
+	reply, err := pc.GetPhrases(contentLanguage)
+	if err != nil {
+		return nil, err
+	}
+	gp, apiErr := structs.Decode[structs.GetPhrasesReturn](reply)
+	if apiErr != nil {
+		return nil, errors.New("PhraseCache Exception: " + apiErr.Error())
+	}
+	return gp.Phrases, nil
+}
+
+// Invalidate drops the cached phrases for the given content languages, or
+// for every content language if none are given. Call it whenever the
+// account's phrase list changes
+func (pc *PhraseCache) Invalidate(contentLanguages ...string) {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+	pc.generation++
+	if len(contentLanguages) == 0 {
+		pc.entries = make(map[string]phraseCacheEntry)
+		return
+	}
+	for _, contentLanguage := range contentLanguages {
+		delete(pc.entries, contentLanguage)
+	}
+}
+
+// StartRefresh refreshes every cached content language in the background
+// once per interval so callers rarely have to wait on a miss. A failed
+// refresh keeps the previously cached phrases until they expire, after which
+// they are dropped rather than refreshed. Only the first call starts a
+// refresh, and a non-positive interval defaults to a minute
+func (pc *PhraseCache) StartRefresh(interval time.Duration) {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	pc.startOnce.Do(func() {
+		go func() {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ticker.C:
+					pc.refresh()
+				case <-pc.stop:
+					return
+				}
+			}
+		}()
+	})
+}
+
+// Stop ends the background refresh started by StartRefresh
+func (pc *PhraseCache) Stop() {
+	pc.stopOnce.Do(func() {
+		close(pc.stop)
+	})
+}
+
+func (pc *PhraseCache) refresh() {
+	now := time.Now()
+	pc.mu.Lock()
+	contentLanguages := make([]string, 0, len(pc.entries))
+	for contentLanguage, entry := range pc.entries {
+		// Expired entries are fetched again by the next caller that needs them
+		if !now.Before(entry.expires) {
+			delete(pc.entries, contentLanguage)
+			continue
+		}
+		contentLanguages = append(contentLanguages, contentLanguage)
+	}
+	pc.mu.Unlock()
+
+	for _, contentLanguage := range contentLanguages {
+		pc.fetch(contentLanguage)
+	}
+}
+
+func (pc *PhraseCache) fetch(contentLanguage string) ([]byte, error) {
+	// Callers arriving after an Invalidate must not share a call that
+	// started before it
+	pc.mu.RLock()
+	generation := pc.generation
+	pc.mu.RUnlock()
+	key := strconv.Itoa(generation) + " " + contentLanguage
+
+	reply, err := pc.flight.do(key, func() (interface{}, error) {
+		reply, err := pc.vi.GetPhrases(contentLanguage)
+		if err != nil {
+			return reply, err
+		}
+		if _, apiErr := structs.Decode[structs.Envelope](reply); apiErr == nil {
+			// Responses fetched before an Invalidate may already be stale
+			pc.mu.Lock()
+			if generation == pc.generation {
+				pc.entries[contentLanguage] = phraseCacheEntry{reply: reply, expires: time.Now().Add(pc.ttl)}
+			}
+			pc.mu.Unlock()
+		}
+		return reply, nil
+	})
+	// Each caller gets its own copy of the shared reply
+	return append([]byte(nil), reply.([]byte)...), err
+}
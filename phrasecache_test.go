@@ -0,0 +1,157 @@
+package voiceit2
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPhraseCache(t *testing.T) {
+	assert := assert.New(t)
+
+	var hits int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		<-release
+		w.Write([]byte(`{"status":200,"responseCode":"SUCC","count":1,"phrases":[{"text":"never forget tomorrow is a new day","contentLanguage":"en-US"}]}`))
+	}))
+	defer server.Close()
+
+	pc := NewPhraseCache(NewClient("key", "tok", server.URL), time.Minute)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := pc.GetPhrases("en-US")
+			assert.Equal(nil, err)
+		}()
+	}
+	assert.Eventually(func() bool { return flightWaiters(&pc.flight, "0 en-US") == 9 }, time.Second, time.Millisecond)
+	close(release)
+	wg.Wait()
+	assert.Equal(int32(1), atomic.LoadInt32(&hits), "concurrent misses should share one call")
+
+	phrases, err := pc.Phrases("en-US")
+	assert.Equal(nil, err)
+	assert.Equal(1, len(phrases))
+	assert.Equal(int32(1), atomic.LoadInt32(&hits), "cached phrases should not call the API")
+
+	// Callers must not be able to change the cache
+	reply, _ := pc.GetPhrases("en-US")
+	reply[0] = 'x'
+	reply, _ = pc.GetPhrases("en-US")
+	assert.Equal(byte('{'), reply[0])
+
+	pc.Invalidate("en-US")
+	_, err = pc.GetPhrases("en-US")
+	assert.Equal(nil, err)
+	assert.Equal(int32(2), atomic.LoadInt32(&hits), "invalidated phrases should be fetched again")
+
+	pc.StartRefresh(time.Millisecond)
+	assert.Eventually(func() bool { return atomic.LoadInt32(&hits) > 2 }, time.Second, time.Millisecond, "background refresh should call the API")
+	pc.Stop()
+}
+
+func TestPhraseCacheInvalidateDuringFetch(t *testing.T) {
+	assert := assert.New(t)
+
+	var hits int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&hits, 1)
+		if n == 1 {
+			<-release
+		}
+		w.Write([]byte(`{"status":200,"responseCode":"SUCC","count":` + strconv.Itoa(int(n)) + `,"phrases":[]}`))
+	}))
+	defer server.Close()
+
+	pc := NewPhraseCache(NewClient("key", "tok", server.URL), time.Minute)
+	stale := make(chan string)
+	go func() {
+		reply, _ := pc.GetPhrases("en-US")
+		stale <- string(reply)
+	}()
+	assert.Eventually(func() bool { return atomic.LoadInt32(&hits) == 1 }, time.Second, time.Millisecond)
+
+	pc.Invalidate()
+	reply, err := pc.GetPhrases("en-US")
+	assert.Equal(nil, err)
+	assert.Contains(string(reply), `"count":2`, "a caller arriving after Invalidate should not get the older reply")
+
+	close(release)
+	assert.Contains(<-stale, `"count":1`)
+	reply, _ = pc.GetPhrases("en-US")
+	assert.Contains(string(reply), `"count":2`, "the reply fetched before Invalidate should not be cached")
+}
+
+func TestPhraseCacheSkipsFailures(t *testing.T) {
+	assert := assert.New(t)
+
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.Write([]byte(`{"status":400,"responseCode":"FAIL","message":"bad content language"}`))
+	}))
+	defer server.Close()
+
+	pc := NewPhraseCache(NewClient("key", "tok", server.URL), time.Minute)
+	pc.GetPhrases("xx-XX")
+	pc.GetPhrases("xx-XX")
+	assert.Equal(int32(2), atomic.LoadInt32(&hits), "failed responses should not be cached")
+
+	_, err := pc.Phrases("xx-XX")
+	assert.NotEqual(nil, err)
+}
+
+func TestPhraseCacheRefreshDropsExpired(t *testing.T) {
+	assert := assert.New(t)
+
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.Write([]byte(`{"status":200,"responseCode":"SUCC","phrases":[]}`))
+	}))
+	defer server.Close()
+
+	pc := NewPhraseCache(NewClient("key", "tok", server.URL), time.Minute)
+	_, err := pc.GetPhrases("en-US")
+	assert.Equal(nil, err)
+
+	pc.mu.Lock()
+	entry := pc.entries["en-US"]
+	entry.expires = time.Now().Add(-time.Second)
+	pc.entries["en-US"] = entry
+	pc.mu.Unlock()
+
+	pc.refresh()
+	assert.Equal(int32(1), atomic.LoadInt32(&hits), "expired entries should not be refreshed")
+	pc.mu.RLock()
+	assert.Empty(pc.entries)
+	pc.mu.RUnlock()
+
+	// Starting twice, or with no interval, must not panic or leak a refresh
+	pc.StartRefresh(0)
+	pc.StartRefresh(time.Millisecond)
+	pc.Stop()
+}
+
+// flightWaiters returns the number of callers waiting on the call for key
+// besides the first
+func flightWaiters(g *flightGroup, key string) int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if c, ok := g.calls[key]; ok {
+		return c.dups
+	}
+	return 0
+}
@@ -0,0 +1,285 @@
+package voiceit2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/voiceittech/VoiceIt2-Go/v2/structs"
+)
+
+// UsageStore records when each user last used their biometric templates
+type UsageStore interface {
+	Touch(userId string, t time.Time) error
+	LastUsed(userId string) (time.Time, bool, error)
+	Forget(userId string) error
+}
+
+// MemoryUsageStore is a UsageStore that keeps records in memory. Its records
+// are lost when the process exits, after which every user appears never to
+// have been used: back it up with persistent storage before running
+// retention with RetentionPolicy.PurgeUntracked set
+type MemoryUsageStore struct {
+	mu       sync.RWMutex
+	lastUsed map[string]time.Time
+}
+
+// NewMemoryUsageStore returns an empty MemoryUsageStore
+func NewMemoryUsageStore() *MemoryUsageStore {
+	return &MemoryUsageStore{lastUsed: make(map[string]time.Time)}
+}
+
+func (ms *MemoryUsageStore) Touch(userId string, t time.Time) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	if t.After(ms.lastUsed[userId]) {
+		ms.lastUsed[userId] = t
+	}
+	return nil
+}
+
+func (ms *MemoryUsageStore) LastUsed(userId string) (time.Time, bool, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+	t, ok := ms.lastUsed[userId]
+	return t, ok, nil
+}
+
+func (ms *MemoryUsageStore) Forget(userId string) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	delete(ms.lastUsed, userId)
+	return nil
+}
+
+// WithUsageTracking records in store the time of every successful
+// verification and enrollment made by the client, as well as the user found
+// by every successful identification. Calls whose use cannot be recorded
+// return the store's error
+func WithUsageTracking(store UsageStore) Option {
+	return WithMiddleware(usageMiddleware(store))
+}
+
+func usageMiddleware(store UsageStore) Middleware {
+	return func(next RoundTrip) RoundTrip {
+		return func(ctx context.Context, op string, req *http.Request) (*Response, error) {
+			endpoint := endpointFor(op)
+			tracked := req.Method == http.MethodPost &&
+				(strings.HasPrefix(endpoint, "/verification/") ||
+					strings.HasPrefix(endpoint, "/enrollments/") ||
+					strings.HasPrefix(endpoint, "/identification/"))
+			if !tracked {
+				return next(ctx, op, req)
+			}
+
+			userId := requestParams(op, req)["userId"]
+			resp, err := next(ctx, op, req)
+			if err != nil || resp.ResponseCode != "SUCC" {
+				return resp, err
+			}
+			if userId == "" {
+				var found struct {
+					UserId string `json:"userId"`
+				}
+				json.Unmarshal(resp.Body, &found)
+				userId = found.UserId
+			}
+			if userId != "" {
+				// Losing a use would let retention purge an active user
+				if err := store.Touch(userId, time.Now()); err != nil {
+					return resp, fmt.Errorf("usage tracking: %w", err)
+				}
+			}
+			return resp, nil
+		}
+	}
+}
+
+// RetentionPolicy controls a retention run
+type RetentionPolicy struct {
+	// MaxInactive is how long a user may go without any activity before
+	// their biometric data is purged
+	MaxInactive time.Duration
+	// DeleteUsers deletes expired users entirely instead of only their
+	// enrollments
+	DeleteUsers bool
+	// DryRun reports what would be purged without deleting anything
+	DryRun bool
+	// PurgeUntracked also purges inactive users for whom the UsageStore has
+	// no record. Without it such users are skipped, as a store that lost its
+	// records, or no store at all, would make active users look inactive
+	PurgeUntracked bool
+}
+
+// RetentionAction describes what a retention run did, or would do, to a user
+type RetentionAction struct {
+	UserId       string    `json:"userId"`
+	LastActivity time.Time `json:"lastActivity"`
+	Action       string    `json:"action"`
+	Error        string    `json:"error,omitempty"`
+}
+
+// RetentionReport summarises a retention run
+type RetentionReport struct {
+	RanAt        time.Time         `json:"ranAt"`
+	Cutoff       time.Time         `json:"cutoff"`
+	DryRun       bool              `json:"dryRun"`
+	UsersChecked int               `json:"usersChecked"`
+	Actions      []RetentionAction `json:"actions"`
+}
+
+// RetentionClient is the part of Client that RunRetention uses
+type RetentionClient interface {
+	Users
+	Enrollments
+}
+
+// RunRetention purges the biometric data of every user whose last activity
+// is older than policy.MaxInactive. A user's last activity is the latest of
+// their creation, their most recent enrollment and the last use recorded in
+// usage, which may be nil. Failures for single users are recorded in the
+// report and do not stop the run. Users whose activity cannot be determined
+// are skipped, as are users usage has no record of unless
+// policy.PurgeUntracked is set
+func RunRetention(vi RetentionClient, usage UsageStore, policy RetentionPolicy) (RetentionReport, error) {
+	if policy.MaxInactive <= 0 {
+		return RetentionReport{}, errors.New("RunRetention Exception: MaxInactive must be positive")
+	}
+	now := time.Now()
+	report := RetentionReport{
+		RanAt:  now,
+		Cutoff: now.Add(-policy.MaxInactive),
+		DryRun: policy.DryRun,
+	}
+
+	reply, err := vi.GetAllUsers()
+	if err != nil {
+		return report, err
+	}
+	gau, apiErr := structs.Decode[structs.GetAllUsersReturn](reply)
+	if apiErr != nil {
+		return report, errors.New("RunRetention Exception: " + apiErr.Error())
+	}
+
+	for _, user := range gau.Users {
+		report.UsersChecked++
+		active, enrollments, tracked, err := lastActivity(vi, usage, user)
+		if err != nil {
+			report.Actions = append(report.Actions, RetentionAction{UserId: user.UserId, Action: "skipped", Error: err.Error()})
+			continue
+		}
+		// Users already purged of their enrollments have nothing left to
+		// delete unless the user itself goes
+		if !active.Before(report.Cutoff) || (enrollments == 0 && !policy.DeleteUsers) {
+			continue
+		}
+		if !tracked && !policy.PurgeUntracked {
+			report.Actions = append(report.Actions, RetentionAction{UserId: user.UserId, LastActivity: active, Action: "skipped", Error: "no recorded usage"})
+			continue
+		}
+
+		action := RetentionAction{UserId: user.UserId, LastActivity: active, Action: "deleteEnrollments"}
+		if policy.DeleteUsers {
+			action.Action = "deleteUser"
+		}
+		if !policy.DryRun {
+			if err := purgeUser(vi, usage, user.UserId, policy.DeleteUsers); err != nil {
+				action.Error = err.Error()
+			}
+		}
+		report.Actions = append(report.Actions, action)
+	}
+	return report, nil
+}
+
+// lastActivity returns the latest activity of user along with their number of
+// enrollments and whether usage holds a record for them
+func lastActivity(vi Enrollments, usage UsageStore, user structs.User) (time.Time, int, bool, error) {
+	latest := user.CreatedAt.Time
+	tracked := false
+	if usage != nil {
+		t, ok, err := usage.LastUsed(user.UserId)
+		if err != nil {
+			return latest, 0, false, err
+		}
+		tracked = ok
+		if ok && t.After(latest) {
+			latest = t
+		}
+	}
+
+	// A listing that failed must not pass for a user without enrollments
+	reply, err := vi.GetAllVoiceEnrollments(user.UserId)
+	if err != nil {
+		return latest, 0, false, err
+	}
+	voice, apiErr := structs.Decode[structs.GetAllVoiceEnrollmentsReturn](reply)
+	if apiErr != nil {
+		return latest, 0, false, apiErr
+	}
+	for _, enrollment := range voice.VoiceEnrollments {
+		if t := enrollment.CreatedAt.Time; t.After(latest) {
+			latest = t
+		}
+	}
+
+	reply, err = vi.GetAllFaceEnrollments(user.UserId)
+	if err != nil {
+		return latest, 0, false, err
+	}
+	face, apiErr := structs.Decode[structs.GetAllFaceEnrollmentsReturn](reply)
+	if apiErr != nil {
+		return latest, 0, false, apiErr
+	}
+	for _, enrollment := range face.FaceEnrollments {
+		if t := enrollment.CreatedAt.Time; t.After(latest) {
+			latest = t
+		}
+	}
+
+	reply, err = vi.GetAllVideoEnrollments(user.UserId)
+	if err != nil {
+		return latest, 0, false, err
+	}
+	video, apiErr := structs.Decode[structs.GetAllVideoEnrollmentsReturn](reply)
+	if apiErr != nil {
+		return latest, 0, false, apiErr
+	}
+	for _, enrollment := range video.VideoEnrollments {
+		if t := enrollment.CreatedAt.Time; t.After(latest) {
+			latest = t
+		}
+	}
+	return latest, len(voice.VoiceEnrollments) + len(face.FaceEnrollments) + len(video.VideoEnrollments), tracked, nil
+}
+
+func purgeUser(vi RetentionClient, usage UsageStore, userId string, deleteUser bool) error {
+	if deleteUser {
+		reply, err := vi.DeleteUser(userId)
+		if err != nil {
+			return err
+		}
+		if _, apiErr := structs.Decode[structs.DeleteUserReturn](reply); apiErr != nil {
+			return apiErr
+		}
+	} else {
+		reply, err := vi.DeleteAllEnrollments(userId)
+		if err != nil {
+			return err
+		}
+		if _, apiErr := structs.Decode[structs.DeleteAllEnrollmentsReturn](reply); apiErr != nil {
+			return apiErr
+		}
+	}
+	if usage != nil {
+		if err := usage.Forget(userId); err != nil {
+			return errors.New("forgetting usage: " + err.Error())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package voiceit2
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunRetention(t *testing.T) {
+	assert := assert.New(t)
+
+	old := strconv.FormatInt(time.Now().Add(-400*24*time.Hour).UnixNano()/int64(time.Millisecond), 10)
+	recent := strconv.FormatInt(time.Now().Add(-time.Hour).UnixNano()/int64(time.Millisecond), 10)
+
+	var mu sync.Mutex
+	var deletes []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.URL.Path == "/users":
+			// usr_stale is inactive, usr_enrolled enrolled recently, usr_used
+			// verified recently and usr_empty has nothing left to delete
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC","users":[` +
+				`{"userId":"usr_stale","createdAt":` + old + `},` +
+				`{"userId":"usr_enrolled","createdAt":` + old + `},` +
+				`{"userId":"usr_used","createdAt":` + old + `},` +
+				`{"userId":"usr_empty","createdAt":` + old + `}]}`))
+		case r.URL.Path == "/enrollments/voice/usr_enrolled":
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC","voiceEnrollments":[{"createdAt":` + recent + `}]}`))
+		case strings.HasPrefix(r.URL.Path, "/enrollments/face/") && !strings.HasSuffix(r.URL.Path, "usr_empty"):
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC","faceEnrollments":[{"createdAt":` + old + `}]}`))
+		case r.Method == "DELETE":
+			deletes = append(deletes, r.URL.Path)
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC"}`))
+		case r.URL.Path == "/verification/voice":
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC","confidence":95}`))
+		default:
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC"}`))
+		}
+	}))
+	defer server.Close()
+
+	usage := NewMemoryUsageStore()
+	myVoiceIt := NewClientWithOptions("key", "tok", WithBaseUrl(server.URL), WithUsageTracking(usage))
+	myVoiceIt.VoiceVerificationByByteSlice("usr_used", "en-US", "phrase", "voice.wav", []byte("voice"))
+	_, ok, _ := usage.LastUsed("usr_used")
+	assert.True(ok, "verification should record usage")
+
+	policy := RetentionPolicy{MaxInactive: 365 * 24 * time.Hour, DryRun: true}
+	report, err := RunRetention(myVoiceIt, usage, policy)
+	assert.Equal(nil, err)
+	assert.Equal(1, len(report.Actions))
+	assert.Equal("usr_stale", report.Actions[0].UserId)
+	assert.Equal("skipped", report.Actions[0].Action, "users without recorded usage should not be purged by default")
+
+	policy.PurgeUntracked = true
+	report, err = RunRetention(myVoiceIt, usage, policy)
+	assert.Equal(nil, err)
+	assert.Equal(4, report.UsersChecked)
+	assert.Equal(1, len(report.Actions))
+	assert.Equal("usr_stale", report.Actions[0].UserId)
+	assert.Equal("deleteEnrollments", report.Actions[0].Action)
+	assert.Equal(0, len(deletes), "dry run should not delete anything")
+
+	policy.DryRun = false
+	policy.DeleteUsers = true
+	report, err = RunRetention(myVoiceIt, usage, policy)
+	assert.Equal(nil, err)
+	assert.Equal(2, len(report.Actions))
+	assert.Equal([]string{"/users/usr_stale", "/users/usr_empty"}, deletes)
+
+	_, err = RunRetention(myVoiceIt, usage, RetentionPolicy{DryRun: true})
+	assert.Error(err, "a zero MaxInactive would purge every user")
+}
+
+func TestRunRetentionSkipsFailedListings(t *testing.T) {
+	assert := assert.New(t)
+
+	old := strconv.FormatInt(time.Now().Add(-400*24*time.Hour).UnixNano()/int64(time.Millisecond), 10)
+	var deletes []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/users":
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC","users":[{"userId":"usr_limited","createdAt":` + old + `}]}`))
+		case r.URL.Path == "/enrollments/video/usr_limited":
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"status":429,"responseCode":"RATE","message":"Rate limit exceeded"}`))
+		case r.Method == "DELETE":
+			deletes = append(deletes, r.URL.Path)
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC"}`))
+		default:
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC"}`))
+		}
+	}))
+	defer server.Close()
+
+	myVoiceIt := NewClient("key", "tok", server.URL)
+	report, err := RunRetention(myVoiceIt, nil, RetentionPolicy{MaxInactive: 24 * time.Hour, DeleteUsers: true})
+	assert.Equal(nil, err)
+	assert.Len(report.Actions, 1)
+	assert.Equal("skipped", report.Actions[0].Action)
+	assert.Contains(report.Actions[0].Error, "RATE")
+	assert.Empty(deletes)
+}
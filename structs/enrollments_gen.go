@@ -0,0 +1,104 @@
+// Code generated by internal/apigen from spec/api.json. DO NOT EDIT.
+
+package structs
+
+type VoiceEnrollment struct {
+	CreatedAt         Timestamp `json:"createdAt"`
+	ContentLanguage   string    `json:"contentLanguage"`
+	VoiceEnrollmentId int       `json:"voiceEnrollmentId"`
+	Text              string    `json:"text"`
+	APICallId         string    `json:"apiCallId"`
+}
+
+type GetAllVoiceEnrollmentsReturn struct {
+	Envelope
+	Count            int               `json:"count"`
+	VoiceEnrollments []VoiceEnrollment `json:"voiceEnrollments"`
+}
+
+type FaceEnrollment struct {
+	CreatedAt        Timestamp `json:"createdAt"`
+	FaceEnrollmentId int       `json:"faceEnrollmentId"`
+	APICallId        string    `json:"apiCallId"`
+}
+
+type GetAllFaceEnrollmentsReturn struct {
+	Envelope
+	Count           int              `json:"count"`
+	FaceEnrollments []FaceEnrollment `json:"faceEnrollments"`
+}
+
+type VideoEnrollment struct {
+	CreatedAt         Timestamp `json:"createdAt"`
+	ContentLanguage   string    `json:"contentLanguage"`
+	VideoEnrollmentId int       `json:"videoEnrollmentId"`
+	Text              string    `json:"text"`
+	APICallId         string    `json:"apiCallId"`
+}
+
+type GetAllVideoEnrollmentsReturn struct {
+	Envelope
+	Count            int               `json:"count"`
+	VideoEnrollments []VideoEnrollment `json:"videoEnrollments"`
+}
+
+type CreateVoiceEnrollmentReturn struct {
+	Envelope
+	ContentLanguage string    `json:"contentLanguage"`
+	Id              int       `json:"id"`
+	Text            string    `json:"text"`
+	TextConfidence  float64   `json:"textConfidence"`
+	CreatedAt       Timestamp `json:"createdAt"`
+}
+
+// CreateVoiceEnrollmentByUrlReturn is the same type as CreateVoiceEnrollmentReturn
+type CreateVoiceEnrollmentByUrlReturn = CreateVoiceEnrollmentReturn
+
+type CreateFaceEnrollmentReturn struct {
+	Envelope
+	FaceEnrollmentId int       `json:"faceEnrollmentId"`
+	CreatedAt        Timestamp `json:"createdAt"`
+}
+
+// CreateFaceEnrollmentByUrlReturn is the same type as CreateFaceEnrollmentReturn
+type CreateFaceEnrollmentByUrlReturn = CreateFaceEnrollmentReturn
+
+type CreateVideoEnrollmentReturn struct {
+	Envelope
+	ContentLanguage string    `json:"contentLanguage"`
+	Id              int       `json:"id"`
+	Text            string    `json:"text"`
+	TextConfidence  float64   `json:"textConfidence"`
+	CreatedAt       Timestamp `json:"createdAt"`
+}
+
+// CreateVideoEnrollmentByUrlReturn is the same type as CreateVideoEnrollmentReturn
+type CreateVideoEnrollmentByUrlReturn = CreateVideoEnrollmentReturn
+
+type DeleteVoiceEnrollmentReturn struct {
+	Envelope
+}
+
+type DeleteFaceEnrollmentReturn struct {
+	Envelope
+}
+
+type DeleteVideoEnrollmentReturn struct {
+	Envelope
+}
+
+type DeleteAllVoiceEnrollmentsReturn struct {
+	Envelope
+}
+
+type DeleteAllFaceEnrollmentsReturn struct {
+	Envelope
+}
+
+type DeleteAllVideoEnrollmentsReturn struct {
+	Envelope
+}
+
+type DeleteAllEnrollmentsReturn struct {
+	Envelope
+}
@@ -0,0 +1,68 @@
+package structs
+
+import "encoding/json"
+
+// Envelope holds the fields every API response shares. It is embedded in
+// every return struct
+type Envelope struct {
+	Status       int      `json:"status"`
+	Message      string   `json:"message"`
+	ResponseCode string   `json:"responseCode"`
+	APICallId    string   `json:"apiCallId"`
+	TimeTaken    Duration `json:"timeTaken"`
+}
+
+// ResponseEnvelope returns the envelope of a response, so that any return
+// struct can be inspected through the Enveloped interface
+func (e Envelope) ResponseEnvelope() Envelope {
+	return e
+}
+
+// Succeeded reports whether the response has the SUCC response code
+func (e Envelope) Succeeded() bool {
+	return e.ResponseCode == "SUCC"
+}
+
+// Enveloped is implemented by every return struct
+type Enveloped interface {
+	ResponseEnvelope() Envelope
+}
+
+// APIError is an unsuccessful API response, or a response that could not be
+// decoded, as returned by Decode
+type APIError struct {
+	Envelope
+	// Err is the error decoding the response, if any
+	Err error
+}
+
+func (e *APIError) Error() string {
+	if e.Err != nil {
+		return e.Err.Error()
+	}
+	return e.ResponseCode + " " + e.Message
+}
+
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// Decode decodes raw, the reply of an API call, into T, a return struct
+// such as CreateUserReturn, e.g.
+//
+//	user, apiErr := structs.Decode[structs.CreateUserReturn](reply)
+//
+// The returned APIError is nil if the response has the SUCC response code
+// and is set otherwise, along with the decoded response, which carries the
+// envelope of the failure
+func Decode[T Enveloped](raw []byte) (T, *APIError) {
+	var v T
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return v, &APIError{Err: err}
+	}
+	envelope := v.ResponseEnvelope()
+	if !envelope.Succeeded() {
+		return v, &APIError{Envelope: envelope}
+	}
+	return v, nil
+}
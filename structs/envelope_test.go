@@ -0,0 +1,38 @@
+package structs
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecode(t *testing.T) {
+	assert := assert.New(t)
+
+	user, apiErr := Decode[CreateUserReturn]([]byte(`{"status":201,"responseCode":"SUCC","message":"Created user","timeTaken":"0.010s","apiCallId":"api_1","userId":"usr_1"}`))
+	assert.Nil(apiErr)
+	assert.Equal("usr_1", user.UserId)
+	assert.Equal("api_1", user.APICallId)
+	assert.Equal(10*time.Millisecond, user.TimeTaken.Duration)
+
+	// ByUrl variants decode into the same type
+	var enrollment CreateVoiceEnrollmentByUrlReturn
+	enrollment, apiErr = Decode[CreateVoiceEnrollmentReturn]([]byte(`{"status":400,"responseCode":"FNFD","message":"File not found"}`))
+	assert.NotNil(apiErr)
+	assert.Equal("FNFD File not found", apiErr.Error())
+	assert.Equal(400, apiErr.Status)
+	assert.Equal(400, enrollment.Status)
+
+	_, apiErr = Decode[GetAllUsersReturn]([]byte(`<html>`))
+	assert.NotNil(apiErr)
+	assert.NotNil(errors.Unwrap(apiErr))
+
+	replies := []Enveloped{user, enrollment}
+	codes := make([]string, len(replies))
+	for i, reply := range replies {
+		codes[i] = reply.ResponseEnvelope().ResponseCode
+	}
+	assert.Equal([]string{"SUCC", "FNFD"}, codes)
+}
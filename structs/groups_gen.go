@@ -0,0 +1,50 @@
+// Code generated by internal/apigen from spec/api.json. DO NOT EDIT.
+
+package structs
+
+type Group struct {
+	CreatedAt   Timestamp `json:"createdAt"`
+	Description string    `json:"description"`
+	GroupId     string    `json:"groupId"`
+	Users       []string  `json:"users"`
+	UserCount   int       `json:"userCount"`
+	APICallId   string    `json:"apiCallId"`
+}
+
+type GetAllGroupsReturn struct {
+	Envelope
+	Count  int     `json:"count"`
+	Groups []Group `json:"groups"`
+}
+
+type GetGroupReturn struct {
+	Envelope
+	CreatedAt   Timestamp `json:"createdAt"`
+	Description string    `json:"description"`
+	Users       []string  `json:"users"`
+	UserCount   int       `json:"userCount"`
+}
+
+type CheckGroupExistsReturn struct {
+	Envelope
+	Exists bool `json:"exists"`
+}
+
+type CreateGroupReturn struct {
+	Envelope
+	Description string    `json:"description"`
+	GroupId     string    `json:"groupId"`
+	CreatedAt   Timestamp `json:"createdAt"`
+}
+
+type AddUserToGroupReturn struct {
+	Envelope
+}
+
+type RemoveUserFromGroupReturn struct {
+	Envelope
+}
+
+type DeleteGroupReturn struct {
+	Envelope
+}
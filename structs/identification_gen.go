@@ -0,0 +1,38 @@
+// Code generated by internal/apigen from spec/api.json. DO NOT EDIT.
+
+package structs
+
+type VoiceIdentificationReturn struct {
+	Envelope
+	UserId         string  `json:"userId"`
+	GroupId        string  `json:"groupId"`
+	Confidence     float64 `json:"confidence"`
+	Text           string  `json:"text"`
+	TextConfidence float64 `json:"textConfidence"`
+}
+
+// VoiceIdentificationByUrlReturn is the same type as VoiceIdentificationReturn
+type VoiceIdentificationByUrlReturn = VoiceIdentificationReturn
+
+type FaceIdentificationReturn struct {
+	Envelope
+	UserId         string  `json:"userId"`
+	GroupId        string  `json:"groupId"`
+	FaceConfidence float64 `json:"faceConfidence"`
+}
+
+// FaceIdentificationByUrlReturn is the same type as FaceIdentificationReturn
+type FaceIdentificationByUrlReturn = FaceIdentificationReturn
+
+type VideoIdentificationReturn struct {
+	Envelope
+	UserId          string  `json:"userId"`
+	GroupId         string  `json:"groupId"`
+	VoiceConfidence float64 `json:"voiceConfidence"`
+	FaceConfidence  float64 `json:"faceConfidence"`
+	Text            string  `json:"text"`
+	TextConfidence  float64 `json:"textConfidence"`
+}
+
+// VideoIdentificationByUrlReturn is the same type as VideoIdentificationReturn
+type VideoIdentificationByUrlReturn = VideoIdentificationReturn
@@ -0,0 +1,39 @@
+package structs
+
+import "log/slog"
+
+// The LogValue methods below keep credentials, user tokens and passwords out
+// of slog output when these structs are logged
+
+func (csr CreateSubAccountRequest) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("email", csr.Email),
+		slog.String("contentLanguage", csr.ContentLanguage),
+	)
+}
+
+func (csr CreateSubAccountReturn) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Int("status", csr.Status),
+		slog.String("responseCode", csr.ResponseCode),
+		slog.String("apiCallId", csr.APICallId),
+		slog.String("email", csr.Email),
+		slog.String("type", csr.Type),
+	)
+}
+
+func (rsr RegenerateSubAccountAPITokenReturn) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Int("status", rsr.Status),
+		slog.String("responseCode", rsr.ResponseCode),
+		slog.String("apiCallId", rsr.APICallId),
+	)
+}
+
+func (cut CreateUserTokenReturn) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Int("status", cut.Status),
+		slog.String("responseCode", cut.ResponseCode),
+		slog.String("apiCallId", cut.APICallId),
+	)
+}
@@ -0,0 +1,15 @@
+// Code generated by internal/apigen from spec/api.json. DO NOT EDIT.
+
+package structs
+
+type Phrase struct {
+	Text            string `json:"text"`
+	ContentLanguage string `json:"contentLanguage"`
+	APICallId       string `json:"apiCallId"`
+}
+
+type GetPhrasesReturn struct {
+	Envelope
+	Count   int      `json:"count"`
+	Phrases []Phrase `json:"phrases"`
+}
@@ -0,0 +1,28 @@
+// Code generated by internal/apigen from spec/api.json. DO NOT EDIT.
+
+package structs
+
+type CreateSubAccountReturn struct {
+	Envelope
+	Password                string `json:"password"`
+	APIKey                  string `json:"apiKey"`
+	APIToken                string `json:"apiToken"`
+	ContentLanguage         string `json:"contentLanguage"`
+	Email                   string `json:"email"`
+	EmailValidationRequired bool   `json:"emailValidationRequired"`
+	Type                    string `json:"type"`
+}
+
+type RegenerateSubAccountAPITokenReturn struct {
+	Envelope
+	APIToken string `json:"apiToken"`
+}
+
+type DeleteSubAccountReturn struct {
+	Envelope
+}
+
+type SwitchSubAccountTypeReturn struct {
+	Envelope
+	Type string `json:"type"`
+}
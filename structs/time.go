@@ -0,0 +1,96 @@
+package structs
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Timestamp is a time the API sends as milliseconds since the Unix epoch,
+// such as the createdAt field of users, groups and enrollments. A zero
+// Timestamp is sent as 0
+type Timestamp struct {
+	time.Time
+}
+
+// TimestampMillis returns the Timestamp ms milliseconds after the Unix epoch
+func TimestampMillis(ms int64) Timestamp {
+	if ms == 0 {
+		return Timestamp{}
+	}
+	return Timestamp{time.UnixMilli(ms)}
+}
+
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("0"), nil
+	}
+	return []byte(strconv.FormatInt(t.UnixMilli(), 10)), nil
+}
+
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*t = Timestamp{}
+		return nil
+	}
+	var ms json.Number
+	if err := json.Unmarshal(data, &ms); err != nil {
+		return err
+	}
+	millis, err := ms.Int64()
+	if err != nil {
+		f, err := ms.Float64()
+		if err != nil {
+			return err
+		}
+		millis = int64(f)
+	}
+	*t = TimestampMillis(millis)
+	return nil
+}
+
+// Duration is a duration the API sends as a number of seconds followed by
+// "s", such as the timeTaken field of every response, e.g. "0.532s". A
+// Duration decoded from JSON is encoded back exactly as it was received
+// unless it was changed
+type Duration struct {
+	time.Duration
+
+	// text is the value as received, kept so it round-trips unchanged
+	text string
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	if d.text != "" {
+		if parsed, _ := parseSeconds(d.text); parsed == d.Duration {
+			return json.Marshal(d.text)
+		}
+	}
+	return json.Marshal(strconv.FormatFloat(d.Seconds(), 'f', 3, 64) + "s")
+}
+
+// UnmarshalJSON decodes a Duration. A value that is not a number of seconds
+// decodes to zero but is still encoded back as received
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*d = Duration{}
+		return nil
+	}
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	parsed, _ := parseSeconds(text)
+	*d = Duration{Duration: parsed, text: text}
+	return nil
+}
+
+func parseSeconds(text string) (time.Duration, error) {
+	text = strings.TrimSpace(text)
+	if !strings.HasSuffix(text, "s") {
+		text += "s"
+	}
+	return time.ParseDuration(text)
+}
@@ -0,0 +1,43 @@
+package structs
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeTypes(t *testing.T) {
+	assert := assert.New(t)
+
+	wire := `{"createdAt":1487119914000,"status":200,"message":"ok","responseCode":"SUCC","timeTaken":"0.532s","groupId":"grp_1","description":"staff","apiCallId":"api_1"}`
+	var group CreateGroupReturn
+	assert.Nil(json.Unmarshal([]byte(wire), &group))
+	assert.True(group.CreatedAt.Equal(time.Date(2017, 2, 15, 0, 51, 54, 0, time.UTC)))
+	assert.Equal(532*time.Millisecond, group.TimeTaken.Duration)
+
+	encoded, err := json.Marshal(group)
+	assert.Nil(err)
+	assert.JSONEq(wire, string(encoded))
+
+	for _, timeTaken := range []string{"1.2s", "2s", "0.0001s"} {
+		var d Duration
+		assert.Nil(json.Unmarshal([]byte(`"`+timeTaken+`"`), &d))
+		encoded, _ := json.Marshal(d)
+		assert.Equal(`"`+timeTaken+`"`, string(encoded), "timeTaken should round-trip unchanged")
+	}
+
+	var d Duration
+	assert.Nil(json.Unmarshal([]byte(`"1.2s"`), &d))
+	d.Duration = 1500 * time.Millisecond
+	encoded, _ = json.Marshal(d)
+	assert.Equal(`"1.500s"`, string(encoded))
+
+	var empty CreateGroupReturn
+	assert.Nil(json.Unmarshal([]byte(`{"createdAt":0}`), &empty))
+	assert.True(empty.CreatedAt.IsZero())
+	encoded, _ = json.Marshal(empty.CreatedAt)
+	assert.Equal("0", string(encoded))
+	assert.Equal(int64(1487119914000), TimestampMillis(1487119914000).UnixMilli())
+}
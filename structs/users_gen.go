@@ -0,0 +1,44 @@
+// Code generated by internal/apigen from spec/api.json. DO NOT EDIT.
+
+package structs
+
+type User struct {
+	CreatedAt Timestamp `json:"createdAt"`
+	UserId    string    `json:"userId"`
+}
+
+type GetAllUsersReturn struct {
+	Envelope
+	Count int    `json:"count"`
+	Users []User `json:"users"`
+}
+
+type CreateUserReturn struct {
+	Envelope
+	UserId string `json:"userId"`
+}
+
+type CheckUserExistsReturn struct {
+	Envelope
+	Exists bool `json:"exists"`
+}
+
+type DeleteUserReturn struct {
+	Envelope
+}
+
+type GetGroupsForUserReturn struct {
+	Envelope
+	Groups []string `json:"groups"`
+	Count  int      `json:"count"`
+}
+
+type CreateUserTokenReturn struct {
+	Envelope
+	UserToken string    `json:"userToken"`
+	CreatedAt Timestamp `json:"createdAt"`
+}
+
+type ExpireUserTokensReturn struct {
+	Envelope
+}
@@ -0,0 +1,32 @@
+// Code generated by internal/apigen from spec/api.json. DO NOT EDIT.
+
+package structs
+
+type VoiceVerificationReturn struct {
+	Envelope
+	Confidence     float64 `json:"confidence"`
+	Text           string  `json:"text"`
+	TextConfidence float64 `json:"textConfidence"`
+}
+
+// VoiceVerificationByUrlReturn is the same type as VoiceVerificationReturn
+type VoiceVerificationByUrlReturn = VoiceVerificationReturn
+
+type FaceVerificationReturn struct {
+	Envelope
+	FaceConfidence float64 `json:"faceConfidence"`
+}
+
+// FaceVerificationByUrlReturn is the same type as FaceVerificationReturn
+type FaceVerificationByUrlReturn = FaceVerificationReturn
+
+type VideoVerificationReturn struct {
+	Envelope
+	VoiceConfidence float64 `json:"voiceConfidence"`
+	FaceConfidence  float64 `json:"faceConfidence"`
+	Text            string  `json:"text"`
+	TextConfidence  float64 `json:"textConfidence"`
+}
+
+// VideoVerificationByUrlReturn is the same type as VideoVerificationReturn
+type VideoVerificationByUrlReturn = VideoVerificationReturn
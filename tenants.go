@@ -0,0 +1,301 @@
+package voiceit2
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+
+	"github.com/voiceittech/VoiceIt2-Go/v2/structs"
+)
+
+// TenantCredentials are the stored credentials of a tenant's sub-account
+type TenantCredentials struct {
+	APIKey   string `json:"apiKey"`
+	APIToken string `json:"apiToken"`
+	// Type is either "managed" or "unmanaged"
+	Type string `json:"type"`
+}
+
+// TenantStore persists sub-account credentials by tenant id
+type TenantStore interface {
+	Get(tenantID string) (TenantCredentials, bool, error)
+	Put(tenantID string, credentials TenantCredentials) error
+	Delete(tenantID string) error
+}
+
+// MemoryTenantStore is a TenantStore that keeps credentials in memory
+type MemoryTenantStore struct {
+	mu      sync.RWMutex
+	tenants map[string]TenantCredentials
+}
+
+// NewMemoryTenantStore returns an empty MemoryTenantStore
+func NewMemoryTenantStore() *MemoryTenantStore {
+	return &MemoryTenantStore{tenants: make(map[string]TenantCredentials)}
+}
+
+func (ms *MemoryTenantStore) Get(tenantID string) (TenantCredentials, bool, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+	credentials, ok := ms.tenants[tenantID]
+	return credentials, ok, nil
+}
+
+func (ms *MemoryTenantStore) Put(tenantID string, credentials TenantCredentials) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	ms.tenants[tenantID] = credentials
+	return nil
+}
+
+func (ms *MemoryTenantStore) Delete(tenantID string) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	delete(ms.tenants, tenantID)
+	return nil
+}
+
+// TenantManager provisions one sub-account per tenant using the parent
+// account client, stores its credentials and hands out clients for it
+type TenantManager struct {
+	vi    SubAccounts
+	store TenantStore
+
+	// mu guards credentials and locks. API calls for a tenant hold only
+	// that tenant's lock, so tenants never wait on each other
+	mu          sync.Mutex
+	credentials map[string]*RotatingCredentials
+	locks       map[string]*tenantLock
+}
+
+// tenantLock is dropped from TenantManager.locks once nobody holds or waits
+// for it
+type tenantLock struct {
+	mu   sync.Mutex
+	refs int
+}
+
+// UnstoredCredentialsError is returned by RotateToken when the API rotated
+// the token but the new credentials could not be stored. The store still
+// holds the old, revoked token until Credentials are saved
+type UnstoredCredentialsError struct {
+	TenantID    string
+	Credentials TenantCredentials
+	Err         error
+}
+
+func (e *UnstoredCredentialsError) Error() string {
+	return "TenantManager Exception: the rotated token of tenant " + e.TenantID + " could not be stored: " + e.Err.Error()
+}
+
+func (e *UnstoredCredentialsError) Unwrap() error {
+	return e.Err
+}
+
+// NewTenantManager returns a TenantManager that manages sub-accounts of the
+// account behind vi and persists their credentials in store. ClientFor only
+// works if vi is a VoiceIt2
+func NewTenantManager(vi SubAccounts, store TenantStore) *TenantManager {
+	return &TenantManager{
+		vi:          vi,
+		store:       store,
+		credentials: make(map[string]*RotatingCredentials),
+		locks:       make(map[string]*tenantLock),
+	}
+}
+
+// lock locks tenantID and returns the function that unlocks it
+func (tm *TenantManager) lock(tenantID string) func() {
+	tm.mu.Lock()
+	l, ok := tm.locks[tenantID]
+	if !ok {
+		l = &tenantLock{}
+		tm.locks[tenantID] = l
+	}
+	l.refs++
+	tm.mu.Unlock()
+	l.mu.Lock()
+	return func() {
+		l.mu.Unlock()
+		tm.mu.Lock()
+		l.refs--
+		if l.refs == 0 {
+			delete(tm.locks, tenantID)
+		}
+		tm.mu.Unlock()
+	}
+}
+
+// Provision creates a managed or unmanaged sub-account for tenantID and
+// stores its credentials. It fails if the tenant already has one. If the
+// credentials cannot be stored the new sub-account is deleted again, and its
+// API key is included in the error when that fails too
+func (tm *TenantManager) Provision(tenantID string, params structs.CreateSubAccountRequest, managed bool) (TenantCredentials, error) {
+	defer tm.lock(tenantID)()
+
+	if _, ok, err := tm.store.Get(tenantID); err != nil {
+		return TenantCredentials{}, errors.New("TenantManager Exception: " + err.Error())
+	} else if ok {
+		return TenantCredentials{}, errors.New("TenantManager Exception: tenant " + tenantID + " already has a sub-account")
+	}
+
+	var reply []byte
+	var err error
+	if managed {
+		reply, err = tm.vi.CreateManagedSubAccount(params)
+	} else {
+		reply, err = tm.vi.CreateUnmanagedSubAccount(params)
+	}
+	if err != nil {
+		return TenantCredentials{}, err
+	}
+	csa, apiErr := structs.Decode[structs.CreateSubAccountReturn](reply)
+	if apiErr != nil {
+		return TenantCredentials{}, errors.New("TenantManager Exception: " + apiErr.Error())
+	}
+
+	credentials := TenantCredentials{APIKey: csa.APIKey, APIToken: csa.APIToken, Type: csa.Type}
+	if err := tm.store.Put(tenantID, credentials); err != nil {
+		// Without stored credentials nothing would ever reach the sub-account
+		if deleteErr := tm.deleteSubAccount(csa.APIKey); deleteErr != nil {
+			return TenantCredentials{}, errors.New("TenantManager Exception: " + err.Error() + ", sub-account " + csa.APIKey + " was created but could not be deleted: " + deleteErr.Error())
+		}
+		return TenantCredentials{}, errors.New("TenantManager Exception: " + err.Error())
+	}
+	return credentials, nil
+}
+
+// ClientFor returns a client for tenantID's sub-account. Clients of the same
+// tenant share its credentials, which are loaded from the store the first
+// time they are needed
+func (tm *TenantManager) ClientFor(tenantID string) (VoiceIt2, error) {
+	client, ok := tm.vi.(VoiceIt2)
+	if !ok {
+		return VoiceIt2{}, errors.New("TenantManager Exception: tenant clients can only be built from a VoiceIt2 client")
+	}
+	defer tm.lock(tenantID)()
+	rc, err := tm.rotatingLocked(tenantID)
+	if err != nil {
+		return VoiceIt2{}, err
+	}
+	client.APIKey, client.APIToken = "", ""
+	client.Credentials = rc
+	return client, nil
+}
+
+// RotateToken regenerates the API token of tenantID's sub-account, stores it
+// and swaps it into the tenant's cached client. If the token was rotated but
+// could not be stored, the error is an *UnstoredCredentialsError holding the
+// new credentials
+func (tm *TenantManager) RotateToken(tenantID string) error {
+	defer tm.lock(tenantID)()
+	rc, err := tm.rotatingLocked(tenantID)
+	if err != nil {
+		return err
+	}
+	credentials, _, err := tm.store.Get(tenantID)
+	if err != nil {
+		return errors.New("TenantManager Exception: " + err.Error())
+	}
+	if err := RotateSubAccountAPIToken(tm.vi, credentials.APIKey, rc); err != nil {
+		return err
+	}
+	_, credentials.APIToken, _ = rc.Credentials()
+	if err := tm.store.Put(tenantID, credentials); err != nil {
+		return &UnstoredCredentialsError{TenantID: tenantID, Credentials: credentials, Err: err}
+	}
+	return nil
+}
+
+// SwitchType switches tenantID's sub-account between managed and unmanaged
+// and returns the new type
+func (tm *TenantManager) SwitchType(tenantID string) (string, error) {
+	defer tm.lock(tenantID)()
+	credentials, err := tm.credentialsLocked(tenantID)
+	if err != nil {
+		return "", err
+	}
+
+	reply, err := tm.vi.SwitchSubAccountType(credentials.APIKey)
+	if err != nil {
+		return "", err
+	}
+	ssat, apiErr := structs.Decode[structs.SwitchSubAccountTypeReturn](reply)
+	if apiErr != nil {
+		return "", errors.New("TenantManager Exception: " + apiErr.Error())
+	}
+
+	credentials.Type = ssat.Type
+	if err := tm.store.Put(tenantID, credentials); err != nil {
+		return "", errors.New("TenantManager Exception: " + err.Error())
+	}
+	return ssat.Type, nil
+}
+
+// Delete deletes tenantID's sub-account, then removes its stored credentials
+// and cached credentials. A sub-account the API no longer knows counts as deleted
+func (tm *TenantManager) Delete(tenantID string) error {
+	defer tm.lock(tenantID)()
+	credentials, err := tm.credentialsLocked(tenantID)
+	if err != nil {
+		return err
+	}
+
+	reply, err := tm.vi.DeleteSubAccount(credentials.APIKey)
+	if err != nil {
+		return err
+	}
+	if _, apiErr := structs.Decode[structs.DeleteSubAccountReturn](reply); apiErr != nil && apiErr.Status != http.StatusNotFound {
+		return errors.New("TenantManager Exception: " + apiErr.Error())
+	}
+
+	tm.mu.Lock()
+	delete(tm.credentials, tenantID)
+	tm.mu.Unlock()
+	if err := tm.store.Delete(tenantID); err != nil {
+		return errors.New("TenantManager Exception: " + err.Error())
+	}
+	return nil
+}
+
+func (tm *TenantManager) deleteSubAccount(apiKey string) error {
+	reply, err := tm.vi.DeleteSubAccount(apiKey)
+	if err != nil {
+		return err
+	}
+	if _, apiErr := structs.Decode[structs.DeleteSubAccountReturn](reply); apiErr != nil {
+		return apiErr
+	}
+	return nil
+}
+
+func (tm *TenantManager) credentialsLocked(tenantID string) (TenantCredentials, error) {
+	credentials, ok, err := tm.store.Get(tenantID)
+	if err != nil {
+		return TenantCredentials{}, errors.New("TenantManager Exception: " + err.Error())
+	}
+	if !ok {
+		return TenantCredentials{}, errors.New("TenantManager Exception: unknown tenant " + tenantID)
+	}
+	return credentials, nil
+}
+
+// rotatingLocked returns the credentials shared by the clients of tenantID,
+// loading them from the store the first time
+func (tm *TenantManager) rotatingLocked(tenantID string) (*RotatingCredentials, error) {
+	tm.mu.Lock()
+	rc, ok := tm.credentials[tenantID]
+	tm.mu.Unlock()
+	if ok {
+		return rc, nil
+	}
+	credentials, err := tm.credentialsLocked(tenantID)
+	if err != nil {
+		return nil, err
+	}
+	rc = NewRotatingCredentials(credentials.APIKey, credentials.APIToken)
+	tm.mu.Lock()
+	tm.credentials[tenantID] = rc
+	tm.mu.Unlock()
+	return rc, nil
+}
@@ -0,0 +1,140 @@
+package voiceit2
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/voiceittech/VoiceIt2-Go/v2/structs"
+)
+
+func TestTenantManager(t *testing.T) {
+	assert := assert.New(t)
+
+	var lastKey, lastToken string
+	deleted := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/subaccount/managed":
+			w.Write([]byte(`{"status":201,"responseCode":"SUCC","apiKey":"key_sub","apiToken":"tok_sub","type":"managed"}`))
+		case r.Method == "POST" && r.URL.Path == "/subaccount/key_sub/switchType":
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC","type":"unmanaged"}`))
+		case r.Method == "POST" && r.URL.Path == "/subaccount/key_sub":
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC","apiToken":"tok_rotated"}`))
+		case r.Method == "DELETE" && r.URL.Path == "/subaccount/key_sub":
+			deleted = true
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC"}`))
+		default:
+			lastKey, lastToken, _ = r.BasicAuth()
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC"}`))
+		}
+	}))
+	defer server.Close()
+
+	store := NewMemoryTenantStore()
+	tm := NewTenantManager(NewClient("key_parent", "tok_parent", server.URL), store)
+
+	credentials, err := tm.Provision("acme", structs.CreateSubAccountRequest{Email: "ops@acme.test"}, true)
+	assert.Equal(nil, err)
+	assert.Equal("key_sub", credentials.APIKey)
+	_, err = tm.Provision("acme", structs.CreateSubAccountRequest{}, true)
+	assert.NotEqual(nil, err, "a tenant should only be provisioned once")
+
+	client, err := tm.ClientFor("acme")
+	assert.Equal(nil, err)
+	client.GetAllUsers()
+	assert.Equal("key_sub", lastKey)
+	assert.Equal("tok_sub", lastToken)
+
+	assert.Equal(nil, tm.RotateToken("acme"))
+	client.GetAllUsers()
+	assert.Equal("tok_rotated", lastToken, "rotation should reach clients already handed out")
+	stored, _, _ := store.Get("acme")
+	assert.Equal("tok_rotated", stored.APIToken)
+
+	subAccountType, err := tm.SwitchType("acme")
+	assert.Equal(nil, err)
+	assert.Equal("unmanaged", subAccountType)
+	stored, _, _ = store.Get("acme")
+	assert.Equal("unmanaged", stored.Type)
+
+	assert.Equal(nil, tm.Delete("acme"))
+	assert.True(deleted)
+	_, ok, _ := store.Get("acme")
+	assert.False(ok, "deleting a tenant should remove its credentials")
+	_, err = tm.ClientFor("acme")
+	assert.NotEqual(nil, err)
+}
+
+type failingTenantStore struct {
+	*MemoryTenantStore
+}
+
+func (fs failingTenantStore) Put(tenantID string, credentials TenantCredentials) error {
+	return errors.New("store unavailable")
+}
+
+func TestTenantManagerProvisionStoreFailure(t *testing.T) {
+	assert := assert.New(t)
+
+	deleteCode := "SUCC"
+	deleted := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/subaccount/managed":
+			w.Write([]byte(`{"status":201,"responseCode":"SUCC","apiKey":"key_sub","apiToken":"tok_sub","type":"managed"}`))
+		case r.Method == "DELETE" && r.URL.Path == "/subaccount/key_sub":
+			deleted++
+			w.Write([]byte(`{"status":200,"responseCode":"` + deleteCode + `"}`))
+		}
+	}))
+	defer server.Close()
+
+	tm := NewTenantManager(NewClient("key_parent", "tok_parent", server.URL), failingTenantStore{NewMemoryTenantStore()})
+
+	_, err := tm.Provision("acme", structs.CreateSubAccountRequest{}, true)
+	assert.Error(err)
+	assert.Equal(1, deleted, "the sub-account should be deleted when its credentials cannot be stored")
+	assert.NotContains(err.Error(), "key_sub")
+
+	deleteCode = "FAIL"
+	_, err = tm.Provision("acme", structs.CreateSubAccountRequest{}, true)
+	assert.Error(err)
+	assert.Contains(err.Error(), "key_sub", "an orphaned sub-account should be named in the error")
+}
+
+func TestTenantManagerRecovery(t *testing.T) {
+	assert := assert.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/subaccount/key_sub":
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC","apiToken":"tok_rotated"}`))
+		case r.Method == "DELETE" && r.URL.Path == "/subaccount/key_sub":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"status":404,"responseCode":"SANF","message":"Sub-account not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	store := failingTenantStore{NewMemoryTenantStore()}
+	store.MemoryTenantStore.Put("acme", TenantCredentials{APIKey: "key_sub", APIToken: "tok_sub", Type: "managed"})
+	tm := NewTenantManager(NewClient("key_parent", "tok_parent", server.URL), store)
+
+	err := tm.RotateToken("acme")
+	var unstored *UnstoredCredentialsError
+	if assert.True(errors.As(err, &unstored), "a rotated token that could not be stored should be returned") {
+		assert.Equal("tok_rotated", unstored.Credentials.APIToken)
+		assert.NotContains(err.Error(), "tok_rotated")
+	}
+
+	assert.Equal(nil, tm.Delete("acme"), "a sub-account that is already gone should count as deleted")
+	_, ok, _ := store.Get("acme")
+	assert.False(ok)
+
+	tm.mu.Lock()
+	assert.Empty(tm.locks, "tenant locks should be dropped once released")
+	tm.mu.Unlock()
+}
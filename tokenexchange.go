@@ -0,0 +1,197 @@
+package voiceit2
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/voiceittech/VoiceIt2-Go/v2/structs"
+)
+
+// SessionResolver authenticates an incoming request against the application's
+// own session and returns the VoiceIt userId it belongs to
+type SessionResolver func(r *http.Request) (userId string, err error)
+
+// TokenExchangeConfig holds the limits applied by a TokenExchangeHandler
+type TokenExchangeConfig struct {
+	// MaxTimeout caps the lifetime of issued tokens, and is also used when
+	// the request does not ask for a specific timeout
+	MaxTimeout time.Duration
+	// RateLimit is the number of requests a single user may make per
+	// RatePeriod, which must then be positive. Zero disables rate limiting
+	RateLimit  int
+	RatePeriod time.Duration
+}
+
+// TokenExchangeResponse is the JSON body returned by a TokenExchangeHandler
+// after issuing a user token
+type TokenExchangeResponse struct {
+	UserToken string `json:"userToken"`
+	UserId    string `json:"userId"`
+	ExpiresAt int64  `json:"expiresAt"`
+}
+
+type tokenExchangeError struct {
+	Error string `json:"error"`
+}
+
+// TokenExchangeHandler is an http.Handler that lets browser and mobile SDKs
+// swap the application's session for a short lived user token. POST requests
+// to a path ending in /token issue a new token, optionally limited by a
+// "timeout" parameter in seconds, and POST requests to a path ending in
+// /revoke expire every token for the session's user. Any other path is not
+// found
+type TokenExchangeHandler struct {
+	vi      VoiceIt2
+	resolve SessionResolver
+	config  TokenExchangeConfig
+
+	mu        sync.Mutex
+	windows   map[string]rateWindow
+	lastSweep time.Time
+}
+
+type rateWindow struct {
+	start time.Time
+	count int
+}
+
+// NewTokenExchangeHandler returns a TokenExchangeHandler that issues tokens
+// with the given client for the userIds returned by resolve. It fails if
+// config would issue tokens that expire at once or silently disable the rate
+// limit
+func NewTokenExchangeHandler(vi VoiceIt2, resolve SessionResolver, config TokenExchangeConfig) (*TokenExchangeHandler, error) {
+	if resolve == nil {
+		return nil, errors.New("TokenExchangeHandler Exception: resolve must not be nil")
+	}
+	if config.MaxTimeout < time.Second {
+		return nil, errors.New("TokenExchangeHandler Exception: MaxTimeout must be at least one second")
+	}
+	if config.RateLimit < 0 {
+		return nil, errors.New("TokenExchangeHandler Exception: RateLimit must not be negative")
+	}
+	if config.RateLimit > 0 && config.RatePeriod <= 0 {
+		return nil, errors.New("TokenExchangeHandler Exception: RatePeriod must be positive when RateLimit is set")
+	}
+	return &TokenExchangeHandler{
+		vi:      vi,
+		resolve: resolve,
+		config:  config,
+		windows: make(map[string]rateWindow),
+	}, nil
+}
+
+func (h *TokenExchangeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeTokenExchangeJSON(w, http.StatusMethodNotAllowed, tokenExchangeError{Error: "method not allowed"})
+		return
+	}
+	revoke := strings.HasSuffix(r.URL.Path, "/revoke")
+	if !revoke && !strings.HasSuffix(r.URL.Path, "/token") {
+		writeTokenExchangeJSON(w, http.StatusNotFound, tokenExchangeError{Error: "not found"})
+		return
+	}
+
+	userId, err := h.resolve(r)
+	if err != nil || userId == "" {
+		writeTokenExchangeJSON(w, http.StatusUnauthorized, tokenExchangeError{Error: "unauthorized"})
+		return
+	}
+
+	if !h.allow(userId) {
+		writeTokenExchangeJSON(w, http.StatusTooManyRequests, tokenExchangeError{Error: "too many requests"})
+		return
+	}
+
+	if revoke {
+		h.revoke(w, userId)
+		return
+	}
+	h.issue(w, r, userId)
+}
+
+func (h *TokenExchangeHandler) issue(w http.ResponseWriter, r *http.Request, userId string) {
+	timeout := h.config.MaxTimeout
+	if requested := r.FormValue("timeout"); requested != "" {
+		seconds, err := strconv.Atoi(requested)
+		if err != nil || seconds <= 0 {
+			writeTokenExchangeJSON(w, http.StatusBadRequest, tokenExchangeError{Error: "invalid timeout"})
+			return
+		}
+		// Compare in seconds, as a large enough request overflows a Duration
+		if seconds < int(timeout/time.Second) {
+			timeout = time.Duration(seconds) * time.Second
+		}
+	}
+
+	issuedAt := time.Now()
+	reply, err := h.vi.CreateUserToken(userId, timeout)
+	if err != nil {
+		writeTokenExchangeJSON(w, http.StatusBadGateway, tokenExchangeError{Error: "could not create user token"})
+		return
+	}
+	cut, apiErr := structs.Decode[structs.CreateUserTokenReturn](reply)
+	if apiErr != nil {
+		writeTokenExchangeJSON(w, http.StatusBadGateway, tokenExchangeError{Error: "could not create user token"})
+		return
+	}
+
+	writeTokenExchangeJSON(w, http.StatusOK, TokenExchangeResponse{
+		UserToken: cut.UserToken,
+		UserId:    userId,
+		ExpiresAt: issuedAt.Add(timeout).Unix(),
+	})
+}
+
+func (h *TokenExchangeHandler) revoke(w http.ResponseWriter, userId string) {
+	reply, err := h.vi.ExpireUserTokens(userId)
+	if err != nil {
+		writeTokenExchangeJSON(w, http.StatusBadGateway, tokenExchangeError{Error: "could not expire user tokens"})
+		return
+	}
+	if _, apiErr := structs.Decode[structs.ExpireUserTokensReturn](reply); apiErr != nil {
+		writeTokenExchangeJSON(w, http.StatusBadGateway, tokenExchangeError{Error: "could not expire user tokens"})
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// allow applies a fixed window rate limit per userId
+func (h *TokenExchangeHandler) allow(userId string) bool {
+	if h.config.RateLimit <= 0 {
+		return true
+	}
+	now := time.Now()
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if now.Sub(h.lastSweep) >= h.config.RatePeriod {
+		for id, window := range h.windows {
+			if now.Sub(window.start) >= h.config.RatePeriod {
+				delete(h.windows, id)
+			}
+		}
+		h.lastSweep = now
+	}
+	window := h.windows[userId]
+	if now.Sub(window.start) >= h.config.RatePeriod {
+		window = rateWindow{start: now}
+	}
+	if window.count >= h.config.RateLimit {
+		return false
+	}
+	window.count++
+	h.windows[userId] = window
+	return true
+}
+
+func writeTokenExchangeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
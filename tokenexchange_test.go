@@ -0,0 +1,108 @@
+package voiceit2
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenExchangeHandler(t *testing.T) {
+	assert := assert.New(t)
+
+	var requestedTimeout, expiredUser string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/expireTokens") {
+			expiredUser = strings.Split(r.URL.Path, "/")[2]
+			w.Write([]byte(`{"status":201,"responseCode":"SUCC"}`))
+			return
+		}
+		requestedTimeout = r.URL.Query().Get("timeOut")
+		w.Write([]byte(`{"status":201,"responseCode":"SUCC","userToken":"utk_abc"}`))
+	}))
+	defer api.Close()
+
+	resolve := func(r *http.Request) (string, error) {
+		if r.Header.Get("Cookie") != "session=ok" {
+			return "", errors.New("no session")
+		}
+		return "usr_1", nil
+	}
+	h, err := NewTokenExchangeHandler(NewClient("key", "tok", api.URL), resolve, TokenExchangeConfig{
+		MaxTimeout: time.Minute,
+		RateLimit:  4,
+		RatePeriod: time.Hour,
+	})
+	assert.Equal(nil, err)
+
+	post := func(target string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", target, nil)
+		req.Header.Set("Cookie", "session=ok")
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := post("/voiceit/token?timeout=3600")
+	assert.Equal(http.StatusOK, rec.Code)
+	assert.Equal("60", requestedTimeout, "timeout should be capped at MaxTimeout")
+	var ter TokenExchangeResponse
+	assert.Equal(nil, json.Unmarshal(rec.Body.Bytes(), &ter))
+	assert.Equal("utk_abc", ter.UserToken)
+	assert.Equal("usr_1", ter.UserId)
+
+	rec = post("/voiceit/token?timeout=10")
+	assert.Equal(http.StatusOK, rec.Code)
+	assert.Equal("10", requestedTimeout)
+
+	rec = post("/voiceit/token?timeout=9300000000")
+	assert.Equal(http.StatusOK, rec.Code)
+	assert.Equal("60", requestedTimeout, "a timeout too large for a Duration should still be capped")
+
+	rec = post("/voiceit/revoke")
+	assert.Equal(http.StatusNoContent, rec.Code)
+	assert.Equal("usr_1", expiredUser)
+
+	rec = post("/voiceit/other")
+	assert.Equal(http.StatusNotFound, rec.Code, "only the token and revoke paths should be served")
+
+	rec = post("/voiceit/token")
+	assert.Equal(http.StatusTooManyRequests, rec.Code)
+
+	req := httptest.NewRequest("POST", "/voiceit/token", nil)
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(http.StatusUnauthorized, rec.Code)
+
+	req = httptest.NewRequest("GET", "/voiceit/token", nil)
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestTokenExchangeConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	resolve := func(r *http.Request) (string, error) { return "usr_1", nil }
+	vi := NewClient("key", "tok")
+	for _, config := range []TokenExchangeConfig{
+		{},
+		{MaxTimeout: time.Millisecond},
+		{MaxTimeout: time.Minute, RateLimit: -1},
+		{MaxTimeout: time.Minute, RateLimit: 3},
+	} {
+		_, err := NewTokenExchangeHandler(vi, resolve, config)
+		assert.Error(err, "%+v should be rejected", config)
+	}
+
+	_, err := NewTokenExchangeHandler(vi, nil, TokenExchangeConfig{MaxTimeout: time.Minute})
+	assert.Error(err, "a nil resolver should be rejected")
+
+	_, err = NewTokenExchangeHandler(vi, resolve, TokenExchangeConfig{MaxTimeout: time.Minute})
+	assert.Equal(nil, err)
+}
@@ -0,0 +1,74 @@
+package voiceit2
+
+import (
+	"context"
+	"net/http"
+)
+
+// Tracer starts a span for every API call. It mirrors the shape of the
+// OpenTelemetry tracer so adapting one takes a few lines. Spans are started
+// from the context the call is made with, see VoiceIt2.WithContext, so they
+// nest under the caller's span
+type Tracer interface {
+	Start(ctx context.Context, spanName string) (context.Context, Span)
+}
+
+// Span is a single traced API call
+type Span interface {
+	SetAttribute(key string, value interface{})
+	RecordError(err error)
+	End()
+}
+
+// TraceContextInjector is implemented by Tracers that propagate the span in
+// ctx to outgoing requests, e.g. as a W3C traceparent header
+type TraceContextInjector interface {
+	Inject(ctx context.Context, header http.Header)
+}
+
+// WithTracer traces every API call made by the client with t
+func WithTracer(t Tracer) Option {
+	return WithMiddleware(tracingMiddleware(t))
+}
+
+func tracingMiddleware(t Tracer) Middleware {
+	return func(next RoundTrip) RoundTrip {
+		return func(ctx context.Context, op string, req *http.Request) (*Response, error) {
+			ctx, span := t.Start(ctx, "VoiceIt "+op)
+			defer span.End()
+
+			span.SetAttribute("voiceit.operation", op)
+			span.SetAttribute("http.request.method", req.Method)
+			span.SetAttribute("http.route", endpointFor(op))
+			span.SetAttribute("http.request.body.size", req.ContentLength)
+
+			req = req.WithContext(ctx)
+			if injector, ok := t.(TraceContextInjector); ok {
+				injector.Inject(ctx, req.Header)
+			}
+
+			resp, err := next(ctx, op, req)
+			if err != nil {
+				span.RecordError(redactedError{err})
+				return resp, err
+			}
+			span.SetAttribute("http.response.status_code", resp.StatusCode)
+			span.SetAttribute("voiceit.response_code", resp.ResponseCode)
+			span.SetAttribute("voiceit.api_call_id", resp.APICallId)
+			return resp, err
+		}
+	}
+}
+
+// redactedError reports an error without the request URL, see redactError
+type redactedError struct {
+	err error
+}
+
+func (re redactedError) Error() string {
+	return redactError(re.err)
+}
+
+func (re redactedError) Unwrap() error {
+	return re.err
+}
@@ -0,0 +1,75 @@
+package voiceit2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testSpanKey struct{}
+
+type testSpan struct {
+	name   string
+	parent *testSpan
+	attrs  map[string]interface{}
+	err    error
+	ended  bool
+}
+
+func (s *testSpan) SetAttribute(key string, value interface{}) { s.attrs[key] = value }
+func (s *testSpan) RecordError(err error)                      { s.err = err }
+func (s *testSpan) End()                                       { s.ended = true }
+
+type testTracer struct {
+	spans []*testSpan
+}
+
+func (tt *testTracer) Start(ctx context.Context, spanName string) (context.Context, Span) {
+	parent, _ := ctx.Value(testSpanKey{}).(*testSpan)
+	span := &testSpan{name: spanName, parent: parent, attrs: make(map[string]interface{})}
+	tt.spans = append(tt.spans, span)
+	return context.WithValue(ctx, testSpanKey{}, span), span
+}
+
+func (tt *testTracer) Inject(ctx context.Context, header http.Header) {
+	span := ctx.Value(testSpanKey{}).(*testSpan)
+	header.Set("traceparent", span.name)
+}
+
+func TestTracer(t *testing.T) {
+	assert := assert.New(t)
+
+	var traceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		w.Write([]byte(`{"status":200,"responseCode":"SUCC","apiCallId":"api_1"}`))
+	}))
+	defer server.Close()
+
+	tracer := &testTracer{}
+	myVoiceIt := NewClientWithOptions("key", "tok", WithBaseUrl(server.URL), WithTracer(tracer))
+
+	ctx, parent := tracer.Start(context.Background(), "handler")
+	_, err := myVoiceIt.WithContext(ctx).VideoIdentificationByByteSlice("grp_1", "en-US", "phrase", "video.mp4", []byte("video"))
+	assert.Equal(nil, err)
+
+	span := tracer.spans[1]
+	assert.Equal("VoiceIt VideoIdentificationByByteSlice", span.name)
+	assert.Equal(parent, span.parent, "span should nest under the caller's span")
+	assert.True(span.ended)
+	assert.Equal("/identification/video", span.attrs["http.route"])
+	assert.Equal("api_1", span.attrs["voiceit.api_call_id"])
+	assert.Equal(200, span.attrs["http.response.status_code"])
+	assert.True(span.attrs["http.request.body.size"].(int64) > 0)
+	assert.Equal("VoiceIt VideoIdentificationByByteSlice", traceparent)
+
+	server.Close()
+	_, err = myVoiceIt.DeleteSubAccount("key_secret")
+	assert.NotEqual(nil, err)
+	span = tracer.spans[2]
+	assert.NotEqual(nil, span.err)
+	assert.NotContains(span.err.Error(), "key_secret")
+}
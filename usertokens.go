@@ -0,0 +1,223 @@
+package voiceit2
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/voiceittech/VoiceIt2-Go/v2/structs"
+)
+
+// UserTokenManager issues user tokens with CreateUserToken, caches them until
+// shortly before they expire and hands out user level VoiceIt2 clients built
+// from them, so the master API token never has to leave the backend
+type UserTokenManager struct {
+	vi            Users
+	lifetime      time.Duration
+	refreshMargin time.Duration
+
+	mu     sync.Mutex
+	tokens map[string]userToken
+	// lastUsed is when a token was last asked for, per user
+	lastUsed map[string]time.Time
+	// generations is bumped by Logout so tokens issued before it are dropped
+	generations map[string]int
+	flight      flightGroup
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	stop      chan struct{}
+}
+
+type userToken struct {
+	token   string
+	expires time.Time
+}
+
+// NewUserTokenManager returns a UserTokenManager that uses the given client to
+// issue tokens valid for lifetime. Cached tokens are replaced once they are
+// within refreshMargin of expiring. Client only works if vi is a VoiceIt2
+func NewUserTokenManager(vi Users, lifetime, refreshMargin time.Duration) *UserTokenManager {
+	return &UserTokenManager{
+		vi:            vi,
+		lifetime:      lifetime,
+		refreshMargin: refreshMargin,
+		tokens:        make(map[string]userToken),
+		lastUsed:      make(map[string]time.Time),
+		generations:   make(map[string]int),
+		stop:          make(chan struct{}),
+	}
+}
+
+// Token returns a user token for the given userId, issuing a new one if no
+// cached token is usable. It fails if the user is logged out while the token
+// is being issued
+func (m *UserTokenManager) Token(userId string) (string, error) {
+	now := time.Now()
+	m.mu.Lock()
+	ut, ok := m.tokens[userId]
+	if ok && now.Add(m.refreshMargin).Before(ut.expires) {
+		m.lastUsed[userId] = now
+		m.mu.Unlock()
+		return ut.token, nil
+	}
+	m.mu.Unlock()
+
+	token, err := m.issue(userId)
+	if err != nil {
+		return "", err
+	}
+	m.mu.Lock()
+	m.lastUsed[userId] = now
+	m.mu.Unlock()
+	return token, nil
+}
+
+// Client returns a VoiceIt2 client with user level rights for the given userId
+func (m *UserTokenManager) Client(userId string) (VoiceIt2, error) {
+	userClient, ok := m.vi.(VoiceIt2)
+	if !ok {
+		return VoiceIt2{}, errors.New("UserTokenManager Exception: user clients can only be built from a VoiceIt2 client")
+	}
+	token, err := m.Token(userId)
+	if err != nil {
+		return VoiceIt2{}, err
+	}
+	userClient.APIKey = token
+	userClient.APIToken = ""
+	userClient.Credentials = nil
+	return userClient, nil
+}
+
+// Logout expires every token issued for the given userId and drops it from
+// the cache. Tokens still being issued when Logout is called are not cached
+func (m *UserTokenManager) Logout(userId string) error {
+	m.mu.Lock()
+	delete(m.tokens, userId)
+	delete(m.lastUsed, userId)
+	m.generations[userId]++
+	m.mu.Unlock()
+
+	reply, err := m.vi.ExpireUserTokens(userId)
+	if err != nil {
+		return err
+	}
+	if _, apiErr := structs.Decode[structs.ExpireUserTokensReturn](reply); apiErr != nil {
+		return errors.New("UserTokenManager Exception: " + apiErr.Error())
+	}
+	return nil
+}
+
+// LogoutAll calls Logout for every userId that currently has a cached token
+// and returns the first error encountered
+func (m *UserTokenManager) LogoutAll() error {
+	m.mu.Lock()
+	userIds := make([]string, 0, len(m.tokens))
+	for userId := range m.tokens {
+		userIds = append(userIds, userId)
+	}
+	m.mu.Unlock()
+
+	var firstErr error
+	for _, userId := range userIds {
+		if err := m.Logout(userId); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// StartRefresh checks the cached tokens once per interval and reissues the
+// ones that are about to expire. Tokens of users who have not asked for one
+// for a whole token lifetime are dropped instead. Only the first call starts
+// a refresh, and a non-positive interval defaults to a minute
+func (m *UserTokenManager) StartRefresh(interval time.Duration) {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	m.startOnce.Do(func() {
+		go func() {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ticker.C:
+					m.refresh()
+				case <-m.stop:
+					return
+				}
+			}
+		}()
+	})
+}
+
+// Stop ends the background refresh started by StartRefresh
+func (m *UserTokenManager) Stop() {
+	m.stopOnce.Do(func() {
+		close(m.stop)
+	})
+}
+
+func (m *UserTokenManager) refresh() {
+	now := time.Now()
+	deadline := now.Add(m.refreshMargin)
+	m.mu.Lock()
+	var userIds []string
+	for userId, ut := range m.tokens {
+		lastUsed, ok := m.lastUsed[userId]
+		// A token just issued to Token has no use recorded yet
+		if deadline.Before(ut.expires) || !ok {
+			continue
+		}
+		if now.Sub(lastUsed) > m.lifetime {
+			delete(m.tokens, userId)
+			delete(m.lastUsed, userId)
+			continue
+		}
+		userIds = append(userIds, userId)
+	}
+	for userId, lastUsed := range m.lastUsed {
+		if _, ok := m.tokens[userId]; !ok && now.Sub(lastUsed) > m.lifetime {
+			delete(m.lastUsed, userId)
+		}
+	}
+	m.mu.Unlock()
+
+	for _, userId := range userIds {
+		m.issue(userId)
+	}
+}
+
+func (m *UserTokenManager) issue(userId string) (string, error) {
+	token, err := m.flight.do(userId, func() (interface{}, error) {
+		// Measure the lifetime from before the call so the cached expiry is
+		// never later than the one the API applies
+		issuedAt := time.Now()
+		m.mu.Lock()
+		generation := m.generations[userId]
+		m.mu.Unlock()
+
+		reply, err := m.vi.CreateUserToken(userId, m.lifetime)
+		if err != nil {
+			return "", err
+		}
+		cut, apiErr := structs.Decode[structs.CreateUserTokenReturn](reply)
+		if apiErr != nil {
+			return "", errors.New("UserTokenManager Exception: " + apiErr.Error())
+		}
+		m.mu.Lock()
+		loggedOut := generation != m.generations[userId]
+		if !loggedOut {
+			m.tokens[userId] = userToken{token: cut.UserToken, expires: issuedAt.Add(m.lifetime)}
+		}
+		m.mu.Unlock()
+		if loggedOut {
+			// The API may have issued the token after Logout expired the
+			// user's tokens, so expire it too rather than hand it out
+			m.vi.ExpireUserTokens(userId)
+			return "", errors.New("UserTokenManager Exception: user " + userId + " was logged out while a token was issued")
+		}
+		return cut.UserToken, nil
+	})
+	return token.(string), err
+}
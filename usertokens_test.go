@@ -0,0 +1,163 @@
+package voiceit2
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserTokenManager(t *testing.T) {
+	assert := assert.New(t)
+
+	var issued, expired int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/token"):
+			n := atomic.AddInt32(&issued, 1)
+			w.Write([]byte(`{"status":201,"responseCode":"SUCC","userToken":"utk_` + strconv.Itoa(int(n)) + `"}`))
+		case strings.HasSuffix(r.URL.Path, "/expireTokens"):
+			atomic.AddInt32(&expired, 1)
+			w.Write([]byte(`{"status":201,"responseCode":"SUCC"}`))
+		case r.URL.Path == "/users":
+			user, _, _ := r.BasicAuth()
+			w.Write([]byte(`{"status":200,"responseCode":"SUCC","message":"` + user + `"}`))
+		}
+	}))
+	defer server.Close()
+
+	m := NewUserTokenManager(NewClient("key", "tok", server.URL), time.Hour, time.Minute)
+
+	token, err := m.Token("usr_1")
+	assert.Equal(nil, err)
+	assert.Equal("utk_1", token)
+
+	token, err = m.Token("usr_1")
+	assert.Equal(nil, err)
+	assert.Equal("utk_1", token, "cached token should be reused")
+
+	userClient, err := m.Client("usr_1")
+	assert.Equal(nil, err)
+	assert.Equal("utk_1", userClient.APIKey)
+	assert.Equal(server.URL, userClient.BaseUrl)
+	ret, err := userClient.GetAllUsers()
+	assert.Equal(nil, err)
+	assert.Contains(string(ret), "utk_1", "user client should authenticate with the user token")
+
+	assert.Equal(nil, m.Logout("usr_1"))
+	assert.Equal(int32(1), atomic.LoadInt32(&expired))
+
+	token, err = m.Token("usr_1")
+	assert.Equal(nil, err)
+	assert.Equal("utk_2", token, "logout should drop the cached token")
+}
+
+func TestUserTokenManagerRefresh(t *testing.T) {
+	assert := assert.New(t)
+
+	var issued int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&issued, 1)
+		w.Write([]byte(`{"status":201,"responseCode":"SUCC","userToken":"utk_` + strconv.Itoa(int(n)) + `"}`))
+	}))
+	defer server.Close()
+
+	// Every token is already inside the refresh margin, so each tick reissues
+	m := NewUserTokenManager(NewClient("key", "tok", server.URL), time.Second, time.Second)
+	_, err := m.Token("usr_1")
+	assert.Equal(nil, err)
+
+	m.StartRefresh(10 * time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	m.Stop()
+	assert.True(atomic.LoadInt32(&issued) > 1, "tokens close to expiry should be reissued")
+}
+
+func TestUserTokenManagerLogoutDuringIssue(t *testing.T) {
+	assert := assert.New(t)
+
+	var issued, expired int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/token") {
+			n := atomic.AddInt32(&issued, 1)
+			if n == 1 {
+				<-release
+			}
+			w.Write([]byte(`{"status":201,"responseCode":"SUCC","userToken":"utk_` + strconv.Itoa(int(n)) + `"}`))
+			return
+		}
+		atomic.AddInt32(&expired, 1)
+		w.Write([]byte(`{"status":201,"responseCode":"SUCC"}`))
+	}))
+	defer server.Close()
+
+	m := NewUserTokenManager(NewClient("key", "tok", server.URL), time.Hour, time.Minute)
+	done := make(chan error)
+	go func() {
+		_, err := m.Token("usr_1")
+		done <- err
+	}()
+	for atomic.LoadInt32(&issued) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	assert.Equal(nil, m.Logout("usr_1"))
+	close(release)
+	assert.Error(<-done, "a user logged out during the call should not get a token")
+	assert.Equal(int32(2), atomic.LoadInt32(&expired), "the token issued during Logout should be expired too")
+
+	token, err := m.Token("usr_1")
+	assert.Equal(nil, err)
+	assert.Equal("utk_2", token, "a token issued before Logout should not be cached")
+}
+
+func TestUserTokenManagerEvictsIdleUsers(t *testing.T) {
+	assert := assert.New(t)
+
+	var issued int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&issued, 1)
+		w.Write([]byte(`{"status":201,"responseCode":"SUCC","userToken":"utk_` + strconv.Itoa(int(n)) + `"}`))
+	}))
+	defer server.Close()
+
+	m := NewUserTokenManager(NewClient("key", "tok", server.URL), 30*time.Millisecond, 30*time.Millisecond)
+	_, err := m.Token("usr_1")
+	assert.Equal(nil, err)
+
+	m.StartRefresh(5 * time.Millisecond)
+	time.Sleep(150 * time.Millisecond)
+	m.Stop()
+	time.Sleep(10 * time.Millisecond)
+	m.mu.Lock()
+	cached := len(m.tokens)
+	m.mu.Unlock()
+	assert.Equal(0, cached, "tokens of idle users should be dropped")
+	n := atomic.LoadInt32(&issued)
+	time.Sleep(30 * time.Millisecond)
+	assert.Equal(n, atomic.LoadInt32(&issued))
+}
+
+func TestUserTokenManagerFailedIssue(t *testing.T) {
+	assert := assert.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":404,"responseCode":"UNFD","message":"User not found"}`))
+	}))
+	defer server.Close()
+
+	m := NewUserTokenManager(NewClient("key", "tok", server.URL), time.Hour, time.Minute)
+	_, err := m.Token("usr_unknown")
+	assert.Error(err)
+	m.mu.Lock()
+	assert.Empty(m.lastUsed, "failed issues should not count as use")
+	m.mu.Unlock()
+
+	m.StartRefresh(0)
+	m.Stop()
+}
@@ -0,0 +1,614 @@
+// Code generated by internal/mockgen from client.go, interfaces_gen.go. DO NOT EDIT.
+
+package voiceit2mock
+
+import (
+	"time"
+
+	"github.com/voiceittech/VoiceIt2-Go/v2"
+	"github.com/voiceittech/VoiceIt2-Go/v2/structs"
+)
+
+// Client is a mock voiceit2.Client. Every call is recorded, see Calls, and
+// answered by the method's Func field if it is set, or else by the responses
+// queued with On
+type Client struct {
+	recorder
+
+	GetAllUsersFunc                                func(opts ...voiceit2.CallOption) ([]byte, error)
+	CreateUserFunc                                 func(opts ...voiceit2.CallOption) ([]byte, error)
+	CheckUserExistsFunc                            func(userId string, opts ...voiceit2.CallOption) ([]byte, error)
+	DeleteUserFunc                                 func(userId string, opts ...voiceit2.CallOption) ([]byte, error)
+	GetGroupsForUserFunc                           func(userId string, opts ...voiceit2.CallOption) ([]byte, error)
+	CreateUserTokenFunc                            func(userId string, timeout time.Duration, opts ...voiceit2.CallOption) ([]byte, error)
+	ExpireUserTokensFunc                           func(userId string, opts ...voiceit2.CallOption) ([]byte, error)
+	GetAllGroupsFunc                               func(opts ...voiceit2.CallOption) ([]byte, error)
+	GetGroupFunc                                   func(groupId string, opts ...voiceit2.CallOption) ([]byte, error)
+	CheckGroupExistsFunc                           func(groupId string, opts ...voiceit2.CallOption) ([]byte, error)
+	CreateGroupFunc                                func(description string, opts ...voiceit2.CallOption) ([]byte, error)
+	AddUserToGroupFunc                             func(groupId, userId string, opts ...voiceit2.CallOption) ([]byte, error)
+	RemoveUserFromGroupFunc                        func(groupId, userId string, opts ...voiceit2.CallOption) ([]byte, error)
+	DeleteGroupFunc                                func(groupId string, opts ...voiceit2.CallOption) ([]byte, error)
+	GetAllVoiceEnrollmentsFunc                     func(userId string, opts ...voiceit2.CallOption) ([]byte, error)
+	GetAllVideoEnrollmentsFunc                     func(userId string, opts ...voiceit2.CallOption) ([]byte, error)
+	GetAllFaceEnrollmentsFunc                      func(userId string, opts ...voiceit2.CallOption) ([]byte, error)
+	CreateVoiceEnrollmentFunc                      func(userId, contentLanguage, phrase, filePath string, opts ...voiceit2.CallOption) ([]byte, error)
+	CreateVoiceEnrollmentByByteSliceFunc           func(userId, contentLanguage, phrase, filename string, fileData []byte, opts ...voiceit2.CallOption) ([]byte, error)
+	CreateVoiceEnrollmentByUrlFunc                 func(userId, contentLanguage, phrase, fileUrl string, opts ...voiceit2.CallOption) ([]byte, error)
+	CreateFaceEnrollmentFunc                       func(userId, filePath string, isPhoto ...bool) ([]byte, error)
+	CreateFaceEnrollmentWithOptionsFunc            func(userId, filePath string, opts ...voiceit2.CallOption) ([]byte, error)
+	CreateFaceEnrollmentByByteSliceFunc            func(userId, filename string, fileData []byte, isPhoto ...bool) ([]byte, error)
+	CreateFaceEnrollmentByByteSliceWithOptionsFunc func(userId, filename string, fileData []byte, opts ...voiceit2.CallOption) ([]byte, error)
+	CreateFaceEnrollmentByUrlFunc                  func(userId, fileUrl string, opts ...voiceit2.CallOption) ([]byte, error)
+	CreateVideoEnrollmentFunc                      func(userId, contentLanguage, phrase, filePath string, opts ...voiceit2.CallOption) ([]byte, error)
+	CreateVideoEnrollmentByByteSliceFunc           func(userId, contentLanguage, phrase, filename string, fileData []byte, opts ...voiceit2.CallOption) ([]byte, error)
+	CreateSplitVideoEnrollmentFunc                 func(userId, contentLanguage, phrase, audioFilePath, photoFilePath string, opts ...voiceit2.CallOption) ([]byte, error)
+	CreateSplitVideoEnrollmentByByteSliceFunc      func(userId, contentLanguage, phrase, audioFilename, photoFilename string, audioFileData, photoFileData []byte, opts ...voiceit2.CallOption) ([]byte, error)
+	CreateVideoEnrollmentByUrlFunc                 func(userId, contentLanguage, phrase, fileUrl string, opts ...voiceit2.CallOption) ([]byte, error)
+	DeleteAllEnrollmentsFunc                       func(userId string, opts ...voiceit2.CallOption) ([]byte, error)
+	DeleteVoiceEnrollmentFunc                      func(userId string, voiceEnrollmentId int, opts ...voiceit2.CallOption) ([]byte, error)
+	DeleteFaceEnrollmentFunc                       func(userId string, faceEnrollmentId int, opts ...voiceit2.CallOption) ([]byte, error)
+	DeleteVideoEnrollmentFunc                      func(userId string, videoEnrollmentId int, opts ...voiceit2.CallOption) ([]byte, error)
+	VoiceVerificationFunc                          func(userId, contentLanguage, phrase, filePath string, opts ...voiceit2.CallOption) ([]byte, error)
+	VoiceVerificationByByteSliceFunc               func(userId, contentLanguage, phrase, filename string, fileData []byte, opts ...voiceit2.CallOption) ([]byte, error)
+	VoiceVerificationByUrlFunc                     func(userId, contentLanguage, phrase, fileUrl string, opts ...voiceit2.CallOption) ([]byte, error)
+	FaceVerificationFunc                           func(userId, filePath string, isPhoto ...bool) ([]byte, error)
+	FaceVerificationWithOptionsFunc                func(userId, filePath string, opts ...voiceit2.CallOption) ([]byte, error)
+	FaceVerificationByByteSliceFunc                func(userId, filename string, fileData []byte, isPhoto ...bool) ([]byte, error)
+	FaceVerificationByByteSliceWithOptionsFunc     func(userId, filename string, fileData []byte, opts ...voiceit2.CallOption) ([]byte, error)
+	FaceVerificationByUrlFunc                      func(userId, fileUrl string, opts ...voiceit2.CallOption) ([]byte, error)
+	VideoVerificationFunc                          func(userId, contentLanguage, phrase, filePath string, opts ...voiceit2.CallOption) ([]byte, error)
+	VideoVerificationByByteSliceFunc               func(userId, contentLanguage, phrase, filename string, fileData []byte, opts ...voiceit2.CallOption) ([]byte, error)
+	SplitVideoVerificationFunc                     func(userId, contentLanguage, phrase, audioFilePath, photoFilePath string, opts ...voiceit2.CallOption) ([]byte, error)
+	SplitVideoVerificationByByteSliceFunc          func(userId, contentLanguage, phrase, audioFilename, photoFilename string, audioFileData, photoFileData []byte, opts ...voiceit2.CallOption) ([]byte, error)
+	VideoVerificationByUrlFunc                     func(userId, contentLanguage, phrase, fileUrl string, opts ...voiceit2.CallOption) ([]byte, error)
+	VoiceIdentificationFunc                        func(groupId, contentLanguage, phrase, filePath string, opts ...voiceit2.CallOption) ([]byte, error)
+	VoiceIdentificationByByteSliceFunc             func(groupId, contentLanguage, phrase, filename string, fileData []byte, opts ...voiceit2.CallOption) ([]byte, error)
+	VoiceIdentificationByUrlFunc                   func(groupId, contentLanguage, phrase, fileUrl string, opts ...voiceit2.CallOption) ([]byte, error)
+	VideoIdentificationFunc                        func(groupId, contentLanguage, phrase, filePath string, opts ...voiceit2.CallOption) ([]byte, error)
+	VideoIdentificationByByteSliceFunc             func(groupId, contentLanguage, phrase, filename string, fileData []byte, opts ...voiceit2.CallOption) ([]byte, error)
+	SplitVideoIdentificationFunc                   func(groupId, contentLanguage, phrase, audioFilePath, photoFilePath string, opts ...voiceit2.CallOption) ([]byte, error)
+	SplitVideoIdentificationByByteSliceFunc        func(groupId, contentLanguage, phrase, audioFilename, photoFilename string, audioFileData, photoFileData []byte, opts ...voiceit2.CallOption) ([]byte, error)
+	VideoIdentificationByUrlFunc                   func(groupId, contentLanguage, phrase, fileUrl string, opts ...voiceit2.CallOption) ([]byte, error)
+	FaceIdentificationFunc                         func(groupId, filePath string, isPhoto ...bool) ([]byte, error)
+	FaceIdentificationWithOptionsFunc              func(groupId, filePath string, opts ...voiceit2.CallOption) ([]byte, error)
+	FaceIdentificationByByteSliceFunc              func(groupId, filename string, fileData []byte, isPhoto ...bool) ([]byte, error)
+	FaceIdentificationByByteSliceWithOptionsFunc   func(groupId, filename string, fileData []byte, opts ...voiceit2.CallOption) ([]byte, error)
+	FaceIdentificationByUrlFunc                    func(groupId, fileUrl string, opts ...voiceit2.CallOption) ([]byte, error)
+	CreateManagedSubAccountFunc                    func(params structs.CreateSubAccountRequest, opts ...voiceit2.CallOption) ([]byte, error)
+	CreateUnmanagedSubAccountFunc                  func(params structs.CreateSubAccountRequest, opts ...voiceit2.CallOption) ([]byte, error)
+	RegenerateSubAccountAPITokenFunc               func(subAccountAPIKey string, opts ...voiceit2.CallOption) ([]byte, error)
+	DeleteSubAccountFunc                           func(subAccountAPIKey string, opts ...voiceit2.CallOption) ([]byte, error)
+	SwitchSubAccountTypeFunc                       func(subAccountAPIKey string, opts ...voiceit2.CallOption) ([]byte, error)
+	GetPhrasesFunc                                 func(contentLanguage string, opts ...voiceit2.CallOption) ([]byte, error)
+}
+
+var _ voiceit2.Client = (*Client)(nil)
+
+func (m *Client) GetAllUsers(opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("GetAllUsers")
+	if m.GetAllUsersFunc != nil {
+		return m.GetAllUsersFunc(opts...)
+	}
+	return m.response("GetAllUsers")
+}
+
+func (m *Client) CreateUser(opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("CreateUser")
+	if m.CreateUserFunc != nil {
+		return m.CreateUserFunc(opts...)
+	}
+	return m.response("CreateUser")
+}
+
+func (m *Client) CheckUserExists(userId string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("CheckUserExists", userId)
+	if m.CheckUserExistsFunc != nil {
+		return m.CheckUserExistsFunc(userId, opts...)
+	}
+	return m.response("CheckUserExists")
+}
+
+func (m *Client) DeleteUser(userId string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("DeleteUser", userId)
+	if m.DeleteUserFunc != nil {
+		return m.DeleteUserFunc(userId, opts...)
+	}
+	return m.response("DeleteUser")
+}
+
+func (m *Client) GetGroupsForUser(userId string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("GetGroupsForUser", userId)
+	if m.GetGroupsForUserFunc != nil {
+		return m.GetGroupsForUserFunc(userId, opts...)
+	}
+	return m.response("GetGroupsForUser")
+}
+
+func (m *Client) CreateUserToken(userId string, timeout time.Duration, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("CreateUserToken", userId, timeout)
+	if m.CreateUserTokenFunc != nil {
+		return m.CreateUserTokenFunc(userId, timeout, opts...)
+	}
+	return m.response("CreateUserToken")
+}
+
+func (m *Client) ExpireUserTokens(userId string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("ExpireUserTokens", userId)
+	if m.ExpireUserTokensFunc != nil {
+		return m.ExpireUserTokensFunc(userId, opts...)
+	}
+	return m.response("ExpireUserTokens")
+}
+
+func (m *Client) GetAllGroups(opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("GetAllGroups")
+	if m.GetAllGroupsFunc != nil {
+		return m.GetAllGroupsFunc(opts...)
+	}
+	return m.response("GetAllGroups")
+}
+
+func (m *Client) GetGroup(groupId string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("GetGroup", groupId)
+	if m.GetGroupFunc != nil {
+		return m.GetGroupFunc(groupId, opts...)
+	}
+	return m.response("GetGroup")
+}
+
+func (m *Client) CheckGroupExists(groupId string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("CheckGroupExists", groupId)
+	if m.CheckGroupExistsFunc != nil {
+		return m.CheckGroupExistsFunc(groupId, opts...)
+	}
+	return m.response("CheckGroupExists")
+}
+
+func (m *Client) CreateGroup(description string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("CreateGroup", description)
+	if m.CreateGroupFunc != nil {
+		return m.CreateGroupFunc(description, opts...)
+	}
+	return m.response("CreateGroup")
+}
+
+func (m *Client) AddUserToGroup(groupId string, userId string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("AddUserToGroup", groupId, userId)
+	if m.AddUserToGroupFunc != nil {
+		return m.AddUserToGroupFunc(groupId, userId, opts...)
+	}
+	return m.response("AddUserToGroup")
+}
+
+func (m *Client) RemoveUserFromGroup(groupId string, userId string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("RemoveUserFromGroup", groupId, userId)
+	if m.RemoveUserFromGroupFunc != nil {
+		return m.RemoveUserFromGroupFunc(groupId, userId, opts...)
+	}
+	return m.response("RemoveUserFromGroup")
+}
+
+func (m *Client) DeleteGroup(groupId string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("DeleteGroup", groupId)
+	if m.DeleteGroupFunc != nil {
+		return m.DeleteGroupFunc(groupId, opts...)
+	}
+	return m.response("DeleteGroup")
+}
+
+func (m *Client) GetAllVoiceEnrollments(userId string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("GetAllVoiceEnrollments", userId)
+	if m.GetAllVoiceEnrollmentsFunc != nil {
+		return m.GetAllVoiceEnrollmentsFunc(userId, opts...)
+	}
+	return m.response("GetAllVoiceEnrollments")
+}
+
+func (m *Client) GetAllVideoEnrollments(userId string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("GetAllVideoEnrollments", userId)
+	if m.GetAllVideoEnrollmentsFunc != nil {
+		return m.GetAllVideoEnrollmentsFunc(userId, opts...)
+	}
+	return m.response("GetAllVideoEnrollments")
+}
+
+func (m *Client) GetAllFaceEnrollments(userId string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("GetAllFaceEnrollments", userId)
+	if m.GetAllFaceEnrollmentsFunc != nil {
+		return m.GetAllFaceEnrollmentsFunc(userId, opts...)
+	}
+	return m.response("GetAllFaceEnrollments")
+}
+
+func (m *Client) CreateVoiceEnrollment(userId string, contentLanguage string, phrase string, filePath string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("CreateVoiceEnrollment", userId, contentLanguage, phrase, filePath)
+	if m.CreateVoiceEnrollmentFunc != nil {
+		return m.CreateVoiceEnrollmentFunc(userId, contentLanguage, phrase, filePath, opts...)
+	}
+	return m.response("CreateVoiceEnrollment")
+}
+
+func (m *Client) CreateVoiceEnrollmentByByteSlice(userId string, contentLanguage string, phrase string, filename string, fileData []byte, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("CreateVoiceEnrollmentByByteSlice", userId, contentLanguage, phrase, filename, fileData)
+	if m.CreateVoiceEnrollmentByByteSliceFunc != nil {
+		return m.CreateVoiceEnrollmentByByteSliceFunc(userId, contentLanguage, phrase, filename, fileData, opts...)
+	}
+	return m.response("CreateVoiceEnrollmentByByteSlice")
+}
+
+func (m *Client) CreateVoiceEnrollmentByUrl(userId string, contentLanguage string, phrase string, fileUrl string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("CreateVoiceEnrollmentByUrl", userId, contentLanguage, phrase, fileUrl)
+	if m.CreateVoiceEnrollmentByUrlFunc != nil {
+		return m.CreateVoiceEnrollmentByUrlFunc(userId, contentLanguage, phrase, fileUrl, opts...)
+	}
+	return m.response("CreateVoiceEnrollmentByUrl")
+}
+
+func (m *Client) CreateFaceEnrollment(userId string, filePath string, isPhoto ...bool) ([]byte, error) {
+	m.record("CreateFaceEnrollment", userId, filePath, isPhoto)
+	if m.CreateFaceEnrollmentFunc != nil {
+		return m.CreateFaceEnrollmentFunc(userId, filePath, isPhoto...)
+	}
+	return m.response("CreateFaceEnrollment")
+}
+
+func (m *Client) CreateFaceEnrollmentWithOptions(userId string, filePath string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("CreateFaceEnrollmentWithOptions", userId, filePath)
+	if m.CreateFaceEnrollmentWithOptionsFunc != nil {
+		return m.CreateFaceEnrollmentWithOptionsFunc(userId, filePath, opts...)
+	}
+	return m.response("CreateFaceEnrollmentWithOptions")
+}
+
+func (m *Client) CreateFaceEnrollmentByByteSlice(userId string, filename string, fileData []byte, isPhoto ...bool) ([]byte, error) {
+	m.record("CreateFaceEnrollmentByByteSlice", userId, filename, fileData, isPhoto)
+	if m.CreateFaceEnrollmentByByteSliceFunc != nil {
+		return m.CreateFaceEnrollmentByByteSliceFunc(userId, filename, fileData, isPhoto...)
+	}
+	return m.response("CreateFaceEnrollmentByByteSlice")
+}
+
+func (m *Client) CreateFaceEnrollmentByByteSliceWithOptions(userId string, filename string, fileData []byte, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("CreateFaceEnrollmentByByteSliceWithOptions", userId, filename, fileData)
+	if m.CreateFaceEnrollmentByByteSliceWithOptionsFunc != nil {
+		return m.CreateFaceEnrollmentByByteSliceWithOptionsFunc(userId, filename, fileData, opts...)
+	}
+	return m.response("CreateFaceEnrollmentByByteSliceWithOptions")
+}
+
+func (m *Client) CreateFaceEnrollmentByUrl(userId string, fileUrl string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("CreateFaceEnrollmentByUrl", userId, fileUrl)
+	if m.CreateFaceEnrollmentByUrlFunc != nil {
+		return m.CreateFaceEnrollmentByUrlFunc(userId, fileUrl, opts...)
+	}
+	return m.response("CreateFaceEnrollmentByUrl")
+}
+
+func (m *Client) CreateVideoEnrollment(userId string, contentLanguage string, phrase string, filePath string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("CreateVideoEnrollment", userId, contentLanguage, phrase, filePath)
+	if m.CreateVideoEnrollmentFunc != nil {
+		return m.CreateVideoEnrollmentFunc(userId, contentLanguage, phrase, filePath, opts...)
+	}
+	return m.response("CreateVideoEnrollment")
+}
+
+func (m *Client) CreateVideoEnrollmentByByteSlice(userId string, contentLanguage string, phrase string, filename string, fileData []byte, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("CreateVideoEnrollmentByByteSlice", userId, contentLanguage, phrase, filename, fileData)
+	if m.CreateVideoEnrollmentByByteSliceFunc != nil {
+		return m.CreateVideoEnrollmentByByteSliceFunc(userId, contentLanguage, phrase, filename, fileData, opts...)
+	}
+	return m.response("CreateVideoEnrollmentByByteSlice")
+}
+
+func (m *Client) CreateSplitVideoEnrollment(userId string, contentLanguage string, phrase string, audioFilePath string, photoFilePath string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("CreateSplitVideoEnrollment", userId, contentLanguage, phrase, audioFilePath, photoFilePath)
+	if m.CreateSplitVideoEnrollmentFunc != nil {
+		return m.CreateSplitVideoEnrollmentFunc(userId, contentLanguage, phrase, audioFilePath, photoFilePath, opts...)
+	}
+	return m.response("CreateSplitVideoEnrollment")
+}
+
+func (m *Client) CreateSplitVideoEnrollmentByByteSlice(userId string, contentLanguage string, phrase string, audioFilename string, photoFilename string, audioFileData []byte, photoFileData []byte, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("CreateSplitVideoEnrollmentByByteSlice", userId, contentLanguage, phrase, audioFilename, photoFilename, audioFileData, photoFileData)
+	if m.CreateSplitVideoEnrollmentByByteSliceFunc != nil {
+		return m.CreateSplitVideoEnrollmentByByteSliceFunc(userId, contentLanguage, phrase, audioFilename, photoFilename, audioFileData, photoFileData, opts...)
+	}
+	return m.response("CreateSplitVideoEnrollmentByByteSlice")
+}
+
+func (m *Client) CreateVideoEnrollmentByUrl(userId string, contentLanguage string, phrase string, fileUrl string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("CreateVideoEnrollmentByUrl", userId, contentLanguage, phrase, fileUrl)
+	if m.CreateVideoEnrollmentByUrlFunc != nil {
+		return m.CreateVideoEnrollmentByUrlFunc(userId, contentLanguage, phrase, fileUrl, opts...)
+	}
+	return m.response("CreateVideoEnrollmentByUrl")
+}
+
+func (m *Client) DeleteAllEnrollments(userId string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("DeleteAllEnrollments", userId)
+	if m.DeleteAllEnrollmentsFunc != nil {
+		return m.DeleteAllEnrollmentsFunc(userId, opts...)
+	}
+	return m.response("DeleteAllEnrollments")
+}
+
+func (m *Client) DeleteVoiceEnrollment(userId string, voiceEnrollmentId int, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("DeleteVoiceEnrollment", userId, voiceEnrollmentId)
+	if m.DeleteVoiceEnrollmentFunc != nil {
+		return m.DeleteVoiceEnrollmentFunc(userId, voiceEnrollmentId, opts...)
+	}
+	return m.response("DeleteVoiceEnrollment")
+}
+
+func (m *Client) DeleteFaceEnrollment(userId string, faceEnrollmentId int, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("DeleteFaceEnrollment", userId, faceEnrollmentId)
+	if m.DeleteFaceEnrollmentFunc != nil {
+		return m.DeleteFaceEnrollmentFunc(userId, faceEnrollmentId, opts...)
+	}
+	return m.response("DeleteFaceEnrollment")
+}
+
+func (m *Client) DeleteVideoEnrollment(userId string, videoEnrollmentId int, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("DeleteVideoEnrollment", userId, videoEnrollmentId)
+	if m.DeleteVideoEnrollmentFunc != nil {
+		return m.DeleteVideoEnrollmentFunc(userId, videoEnrollmentId, opts...)
+	}
+	return m.response("DeleteVideoEnrollment")
+}
+
+func (m *Client) VoiceVerification(userId string, contentLanguage string, phrase string, filePath string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("VoiceVerification", userId, contentLanguage, phrase, filePath)
+	if m.VoiceVerificationFunc != nil {
+		return m.VoiceVerificationFunc(userId, contentLanguage, phrase, filePath, opts...)
+	}
+	return m.response("VoiceVerification")
+}
+
+func (m *Client) VoiceVerificationByByteSlice(userId string, contentLanguage string, phrase string, filename string, fileData []byte, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("VoiceVerificationByByteSlice", userId, contentLanguage, phrase, filename, fileData)
+	if m.VoiceVerificationByByteSliceFunc != nil {
+		return m.VoiceVerificationByByteSliceFunc(userId, contentLanguage, phrase, filename, fileData, opts...)
+	}
+	return m.response("VoiceVerificationByByteSlice")
+}
+
+func (m *Client) VoiceVerificationByUrl(userId string, contentLanguage string, phrase string, fileUrl string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("VoiceVerificationByUrl", userId, contentLanguage, phrase, fileUrl)
+	if m.VoiceVerificationByUrlFunc != nil {
+		return m.VoiceVerificationByUrlFunc(userId, contentLanguage, phrase, fileUrl, opts...)
+	}
+	return m.response("VoiceVerificationByUrl")
+}
+
+func (m *Client) FaceVerification(userId string, filePath string, isPhoto ...bool) ([]byte, error) {
+	m.record("FaceVerification", userId, filePath, isPhoto)
+	if m.FaceVerificationFunc != nil {
+		return m.FaceVerificationFunc(userId, filePath, isPhoto...)
+	}
+	return m.response("FaceVerification")
+}
+
+func (m *Client) FaceVerificationWithOptions(userId string, filePath string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("FaceVerificationWithOptions", userId, filePath)
+	if m.FaceVerificationWithOptionsFunc != nil {
+		return m.FaceVerificationWithOptionsFunc(userId, filePath, opts...)
+	}
+	return m.response("FaceVerificationWithOptions")
+}
+
+func (m *Client) FaceVerificationByByteSlice(userId string, filename string, fileData []byte, isPhoto ...bool) ([]byte, error) {
+	m.record("FaceVerificationByByteSlice", userId, filename, fileData, isPhoto)
+	if m.FaceVerificationByByteSliceFunc != nil {
+		return m.FaceVerificationByByteSliceFunc(userId, filename, fileData, isPhoto...)
+	}
+	return m.response("FaceVerificationByByteSlice")
+}
+
+func (m *Client) FaceVerificationByByteSliceWithOptions(userId string, filename string, fileData []byte, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("FaceVerificationByByteSliceWithOptions", userId, filename, fileData)
+	if m.FaceVerificationByByteSliceWithOptionsFunc != nil {
+		return m.FaceVerificationByByteSliceWithOptionsFunc(userId, filename, fileData, opts...)
+	}
+	return m.response("FaceVerificationByByteSliceWithOptions")
+}
+
+func (m *Client) FaceVerificationByUrl(userId string, fileUrl string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("FaceVerificationByUrl", userId, fileUrl)
+	if m.FaceVerificationByUrlFunc != nil {
+		return m.FaceVerificationByUrlFunc(userId, fileUrl, opts...)
+	}
+	return m.response("FaceVerificationByUrl")
+}
+
+func (m *Client) VideoVerification(userId string, contentLanguage string, phrase string, filePath string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("VideoVerification", userId, contentLanguage, phrase, filePath)
+	if m.VideoVerificationFunc != nil {
+		return m.VideoVerificationFunc(userId, contentLanguage, phrase, filePath, opts...)
+	}
+	return m.response("VideoVerification")
+}
+
+func (m *Client) VideoVerificationByByteSlice(userId string, contentLanguage string, phrase string, filename string, fileData []byte, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("VideoVerificationByByteSlice", userId, contentLanguage, phrase, filename, fileData)
+	if m.VideoVerificationByByteSliceFunc != nil {
+		return m.VideoVerificationByByteSliceFunc(userId, contentLanguage, phrase, filename, fileData, opts...)
+	}
+	return m.response("VideoVerificationByByteSlice")
+}
+
+func (m *Client) SplitVideoVerification(userId string, contentLanguage string, phrase string, audioFilePath string, photoFilePath string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("SplitVideoVerification", userId, contentLanguage, phrase, audioFilePath, photoFilePath)
+	if m.SplitVideoVerificationFunc != nil {
+		return m.SplitVideoVerificationFunc(userId, contentLanguage, phrase, audioFilePath, photoFilePath, opts...)
+	}
+	return m.response("SplitVideoVerification")
+}
+
+func (m *Client) SplitVideoVerificationByByteSlice(userId string, contentLanguage string, phrase string, audioFilename string, photoFilename string, audioFileData []byte, photoFileData []byte, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("SplitVideoVerificationByByteSlice", userId, contentLanguage, phrase, audioFilename, photoFilename, audioFileData, photoFileData)
+	if m.SplitVideoVerificationByByteSliceFunc != nil {
+		return m.SplitVideoVerificationByByteSliceFunc(userId, contentLanguage, phrase, audioFilename, photoFilename, audioFileData, photoFileData, opts...)
+	}
+	return m.response("SplitVideoVerificationByByteSlice")
+}
+
+func (m *Client) VideoVerificationByUrl(userId string, contentLanguage string, phrase string, fileUrl string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("VideoVerificationByUrl", userId, contentLanguage, phrase, fileUrl)
+	if m.VideoVerificationByUrlFunc != nil {
+		return m.VideoVerificationByUrlFunc(userId, contentLanguage, phrase, fileUrl, opts...)
+	}
+	return m.response("VideoVerificationByUrl")
+}
+
+func (m *Client) VoiceIdentification(groupId string, contentLanguage string, phrase string, filePath string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("VoiceIdentification", groupId, contentLanguage, phrase, filePath)
+	if m.VoiceIdentificationFunc != nil {
+		return m.VoiceIdentificationFunc(groupId, contentLanguage, phrase, filePath, opts...)
+	}
+	return m.response("VoiceIdentification")
+}
+
+func (m *Client) VoiceIdentificationByByteSlice(groupId string, contentLanguage string, phrase string, filename string, fileData []byte, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("VoiceIdentificationByByteSlice", groupId, contentLanguage, phrase, filename, fileData)
+	if m.VoiceIdentificationByByteSliceFunc != nil {
+		return m.VoiceIdentificationByByteSliceFunc(groupId, contentLanguage, phrase, filename, fileData, opts...)
+	}
+	return m.response("VoiceIdentificationByByteSlice")
+}
+
+func (m *Client) VoiceIdentificationByUrl(groupId string, contentLanguage string, phrase string, fileUrl string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("VoiceIdentificationByUrl", groupId, contentLanguage, phrase, fileUrl)
+	if m.VoiceIdentificationByUrlFunc != nil {
+		return m.VoiceIdentificationByUrlFunc(groupId, contentLanguage, phrase, fileUrl, opts...)
+	}
+	return m.response("VoiceIdentificationByUrl")
+}
+
+func (m *Client) VideoIdentification(groupId string, contentLanguage string, phrase string, filePath string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("VideoIdentification", groupId, contentLanguage, phrase, filePath)
+	if m.VideoIdentificationFunc != nil {
+		return m.VideoIdentificationFunc(groupId, contentLanguage, phrase, filePath, opts...)
+	}
+	return m.response("VideoIdentification")
+}
+
+func (m *Client) VideoIdentificationByByteSlice(groupId string, contentLanguage string, phrase string, filename string, fileData []byte, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("VideoIdentificationByByteSlice", groupId, contentLanguage, phrase, filename, fileData)
+	if m.VideoIdentificationByByteSliceFunc != nil {
+		return m.VideoIdentificationByByteSliceFunc(groupId, contentLanguage, phrase, filename, fileData, opts...)
+	}
+	return m.response("VideoIdentificationByByteSlice")
+}
+
+func (m *Client) SplitVideoIdentification(groupId string, contentLanguage string, phrase string, audioFilePath string, photoFilePath string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("SplitVideoIdentification", groupId, contentLanguage, phrase, audioFilePath, photoFilePath)
+	if m.SplitVideoIdentificationFunc != nil {
+		return m.SplitVideoIdentificationFunc(groupId, contentLanguage, phrase, audioFilePath, photoFilePath, opts...)
+	}
+	return m.response("SplitVideoIdentification")
+}
+
+func (m *Client) SplitVideoIdentificationByByteSlice(groupId string, contentLanguage string, phrase string, audioFilename string, photoFilename string, audioFileData []byte, photoFileData []byte, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("SplitVideoIdentificationByByteSlice", groupId, contentLanguage, phrase, audioFilename, photoFilename, audioFileData, photoFileData)
+	if m.SplitVideoIdentificationByByteSliceFunc != nil {
+		return m.SplitVideoIdentificationByByteSliceFunc(groupId, contentLanguage, phrase, audioFilename, photoFilename, audioFileData, photoFileData, opts...)
+	}
+	return m.response("SplitVideoIdentificationByByteSlice")
+}
+
+func (m *Client) VideoIdentificationByUrl(groupId string, contentLanguage string, phrase string, fileUrl string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("VideoIdentificationByUrl", groupId, contentLanguage, phrase, fileUrl)
+	if m.VideoIdentificationByUrlFunc != nil {
+		return m.VideoIdentificationByUrlFunc(groupId, contentLanguage, phrase, fileUrl, opts...)
+	}
+	return m.response("VideoIdentificationByUrl")
+}
+
+func (m *Client) FaceIdentification(groupId string, filePath string, isPhoto ...bool) ([]byte, error) {
+	m.record("FaceIdentification", groupId, filePath, isPhoto)
+	if m.FaceIdentificationFunc != nil {
+		return m.FaceIdentificationFunc(groupId, filePath, isPhoto...)
+	}
+	return m.response("FaceIdentification")
+}
+
+func (m *Client) FaceIdentificationWithOptions(groupId string, filePath string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("FaceIdentificationWithOptions", groupId, filePath)
+	if m.FaceIdentificationWithOptionsFunc != nil {
+		return m.FaceIdentificationWithOptionsFunc(groupId, filePath, opts...)
+	}
+	return m.response("FaceIdentificationWithOptions")
+}
+
+func (m *Client) FaceIdentificationByByteSlice(groupId string, filename string, fileData []byte, isPhoto ...bool) ([]byte, error) {
+	m.record("FaceIdentificationByByteSlice", groupId, filename, fileData, isPhoto)
+	if m.FaceIdentificationByByteSliceFunc != nil {
+		return m.FaceIdentificationByByteSliceFunc(groupId, filename, fileData, isPhoto...)
+	}
+	return m.response("FaceIdentificationByByteSlice")
+}
+
+func (m *Client) FaceIdentificationByByteSliceWithOptions(groupId string, filename string, fileData []byte, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("FaceIdentificationByByteSliceWithOptions", groupId, filename, fileData)
+	if m.FaceIdentificationByByteSliceWithOptionsFunc != nil {
+		return m.FaceIdentificationByByteSliceWithOptionsFunc(groupId, filename, fileData, opts...)
+	}
+	return m.response("FaceIdentificationByByteSliceWithOptions")
+}
+
+func (m *Client) FaceIdentificationByUrl(groupId string, fileUrl string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("FaceIdentificationByUrl", groupId, fileUrl)
+	if m.FaceIdentificationByUrlFunc != nil {
+		return m.FaceIdentificationByUrlFunc(groupId, fileUrl, opts...)
+	}
+	return m.response("FaceIdentificationByUrl")
+}
+
+func (m *Client) CreateManagedSubAccount(params structs.CreateSubAccountRequest, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("CreateManagedSubAccount", params)
+	if m.CreateManagedSubAccountFunc != nil {
+		return m.CreateManagedSubAccountFunc(params, opts...)
+	}
+	return m.response("CreateManagedSubAccount")
+}
+
+func (m *Client) CreateUnmanagedSubAccount(params structs.CreateSubAccountRequest, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("CreateUnmanagedSubAccount", params)
+	if m.CreateUnmanagedSubAccountFunc != nil {
+		return m.CreateUnmanagedSubAccountFunc(params, opts...)
+	}
+	return m.response("CreateUnmanagedSubAccount")
+}
+
+func (m *Client) RegenerateSubAccountAPIToken(subAccountAPIKey string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("RegenerateSubAccountAPIToken", subAccountAPIKey)
+	if m.RegenerateSubAccountAPITokenFunc != nil {
+		return m.RegenerateSubAccountAPITokenFunc(subAccountAPIKey, opts...)
+	}
+	return m.response("RegenerateSubAccountAPIToken")
+}
+
+func (m *Client) DeleteSubAccount(subAccountAPIKey string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("DeleteSubAccount", subAccountAPIKey)
+	if m.DeleteSubAccountFunc != nil {
+		return m.DeleteSubAccountFunc(subAccountAPIKey, opts...)
+	}
+	return m.response("DeleteSubAccount")
+}
+
+func (m *Client) SwitchSubAccountType(subAccountAPIKey string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("SwitchSubAccountType", subAccountAPIKey)
+	if m.SwitchSubAccountTypeFunc != nil {
+		return m.SwitchSubAccountTypeFunc(subAccountAPIKey, opts...)
+	}
+	return m.response("SwitchSubAccountType")
+}
+
+func (m *Client) GetPhrases(contentLanguage string, opts ...voiceit2.CallOption) ([]byte, error) {
+	m.record("GetPhrases", contentLanguage)
+	if m.GetPhrasesFunc != nil {
+		return m.GetPhrasesFunc(contentLanguage, opts...)
+	}
+	return m.response("GetPhrases")
+}
@@ -0,0 +1,73 @@
+package voiceit2mock
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/voiceittech/VoiceIt2-Go/v2"
+	"github.com/voiceittech/VoiceIt2-Go/v2/structs"
+)
+
+func TestClient(t *testing.T) {
+	assert := assert.New(t)
+
+	mock := &Client{}
+	var users voiceit2.Users = mock
+
+	mock.OnJSON("CreateUser", structs.CreateUserReturn{Envelope: structs.Envelope{ResponseCode: "SUCC"}, UserId: "usr_1"})
+	mock.OnJSON("CreateUser", structs.CreateUserReturn{Envelope: structs.Envelope{ResponseCode: "SUCC"}, UserId: "usr_2"})
+	reply, err := users.CreateUser()
+	assert.Equal(nil, err)
+	assert.Contains(string(reply), "usr_1")
+	reply, _ = users.CreateUser()
+	assert.Contains(string(reply), "usr_2")
+	reply, _ = users.CreateUser()
+	assert.Contains(string(reply), "usr_2", "the last response should repeat")
+
+	failure := errors.New("down")
+	mock.On("DeleteUser", Response{Err: failure})
+	_, err = users.DeleteUser("usr_1")
+	assert.Equal(failure, err)
+
+	_, err = users.GetAllUsers()
+	assert.True(errors.Is(err, ErrNoResponse))
+
+	mock.FaceVerificationFunc = func(userId, filePath string, isPhoto ...bool) ([]byte, error) {
+		return []byte(`{"responseCode":"SUCC"}`), nil
+	}
+	reply, err = mock.FaceVerification("usr_1", "photo.png", true)
+	assert.Equal(nil, err)
+	assert.Equal(`{"responseCode":"SUCC"}`, string(reply))
+
+	assert.Len(mock.Calls(), 6)
+	assert.Equal([]Call{{Method: "DeleteUser", Args: []interface{}{"usr_1"}}}, mock.CallsTo("DeleteUser"))
+	assert.Equal([]interface{}{"usr_1", "photo.png", []bool{true}}, mock.CallsTo("FaceVerification")[0].Args)
+
+	mock.Reset()
+	assert.Empty(mock.Calls())
+}
+
+func TestClientSubstitutesForHelpers(t *testing.T) {
+	assert := assert.New(t)
+
+	mock := &Client{}
+	mock.On("VoiceIdentificationByByteSlice", Response{Body: []byte(`{"responseCode":"SUCC","userId":"usr_1","confidence":90}`)})
+	mock.On("VoiceVerificationByByteSlice", Response{Body: []byte(`{"responseCode":"SUCC","confidence":95}`)})
+	result, err := voiceit2.IdentifyAndVerify(mock, voiceit2.IdentifyAndVerifyRequest{
+		GroupId:                     "grp_1",
+		IdentifyBy:                  voiceit2.VoiceModality,
+		VerifyBy:                    voiceit2.VoiceModality,
+		MinIdentificationConfidence: 80,
+		MinVerificationConfidence:   80,
+	})
+	assert.Equal(nil, err)
+	assert.True(result.Accepted)
+	assert.Equal("usr_1", mock.CallsTo("VoiceVerificationByByteSlice")[0].Args[0])
+
+	mock.On("GetPhrases", Response{Body: []byte(`{"responseCode":"SUCC","phrases":[{"text":"never forget tomorrow is a new day"}]}`)})
+	phrases, err := voiceit2.NewPhraseCache(mock, time.Minute).Phrases("en-US")
+	assert.Equal(nil, err)
+	assert.Len(phrases, 1)
+}
@@ -0,0 +1,102 @@
+// Package voiceit2mock provides a mock implementation of voiceit2.Client for
+// unit tests that should not reach the API
+package voiceit2mock
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrNoResponse is returned, wrapped, by calls to methods that have neither a
+// Func nor a queued response
+var ErrNoResponse = errors.New("voiceit2mock: no response configured")
+
+// Call is a call made to the mock
+type Call struct {
+	Method string
+	// Args holds the arguments in order, variadic ones as a slice. CallOptions
+	// are passed on to the method's Func but not recorded
+	Args []interface{}
+}
+
+// Response is a canned response returned by the mock
+type Response struct {
+	Body []byte
+	Err  error
+}
+
+type recorder struct {
+	mu        sync.Mutex
+	calls     []Call
+	responses map[string][]Response
+}
+
+// On queues responses for method. Each call consumes one, and the last one is
+// returned for all calls once the others are used up
+func (r *recorder) On(method string, responses ...Response) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.responses == nil {
+		r.responses = make(map[string][]Response)
+	}
+	r.responses[method] = append(r.responses[method], responses...)
+}
+
+// OnJSON queues the JSON encoding of v as a response for method, e.g. one of
+// the return types of the structs package
+func (r *recorder) OnJSON(method string, v interface{}) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		panic("voiceit2mock: " + err.Error())
+	}
+	r.On(method, Response{Body: body})
+}
+
+// Calls returns every call made to the mock, in order
+func (r *recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the calls made to method, in order
+func (r *recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var calls []Call
+	for _, call := range r.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Reset forgets all recorded calls and queued responses
+func (r *recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+	r.responses = nil
+}
+
+func (r *recorder) record(method string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+func (r *recorder) response(method string) ([]byte, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	queued := r.responses[method]
+	if len(queued) == 0 {
+		return nil, fmt.Errorf("%w for %s", ErrNoResponse, method)
+	}
+	if len(queued) > 1 {
+		r.responses[method] = queued[1:]
+	}
+	return queued[0].Body, queued[0].Err
+}
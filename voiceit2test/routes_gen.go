@@ -0,0 +1,149 @@
+// Code generated by internal/apigen from spec/api.json. DO NOT EDIT.
+
+package voiceit2test
+
+import (
+	"github.com/voiceittech/VoiceIt2-Go/v2/structs"
+)
+
+// routes lists the endpoint of every operation
+var routes = []route{
+	{method: "GET", path: "/users", operation: "GetAllUsers"},
+	{method: "POST", path: "/users", operation: "CreateUser"},
+	{method: "GET", path: "/users/{userId}", operation: "CheckUserExists"},
+	{method: "DELETE", path: "/users/{userId}", operation: "DeleteUser"},
+	{method: "GET", path: "/users/{userId}/groups", operation: "GetGroupsForUser"},
+	{method: "GET", path: "/groups", operation: "GetAllGroups"},
+	{method: "GET", path: "/groups/{groupId}", operation: "GetGroup"},
+	{method: "GET", path: "/groups/{groupId}/exists", operation: "CheckGroupExists"},
+	{method: "POST", path: "/groups", operation: "CreateGroup"},
+	{method: "PUT", path: "/groups/addUser", operation: "AddUserToGroup"},
+	{method: "PUT", path: "/groups/removeUser", operation: "RemoveUserFromGroup"},
+	{method: "DELETE", path: "/groups/{groupId}", operation: "DeleteGroup"},
+	{method: "GET", path: "/enrollments/voice/{userId}", operation: "GetAllVoiceEnrollments"},
+	{method: "GET", path: "/enrollments/video/{userId}", operation: "GetAllVideoEnrollments"},
+	{method: "GET", path: "/enrollments/face/{userId}", operation: "GetAllFaceEnrollments"},
+	{method: "POST", path: "/enrollments/voice", operation: "CreateVoiceEnrollment"},
+	{method: "POST", path: "/enrollments/voice", operation: "CreateVoiceEnrollmentByByteSlice"},
+	{method: "POST", path: "/enrollments/voice/byUrl", operation: "CreateVoiceEnrollmentByUrl"},
+	{method: "POST", path: "/enrollments/face", operation: "CreateFaceEnrollment"},
+	{method: "POST", path: "/enrollments/face", operation: "CreateFaceEnrollmentByByteSlice"},
+	{method: "POST", path: "/enrollments/face/byUrl", operation: "CreateFaceEnrollmentByUrl"},
+	{method: "POST", path: "/enrollments/video", operation: "CreateVideoEnrollment"},
+	{method: "POST", path: "/enrollments/video", operation: "CreateVideoEnrollmentByByteSlice"},
+	{method: "POST", path: "/enrollments/video", operation: "CreateSplitVideoEnrollment"},
+	{method: "POST", path: "/enrollments/video", operation: "CreateSplitVideoEnrollmentByByteSlice"},
+	{method: "POST", path: "/enrollments/video/byUrl", operation: "CreateVideoEnrollmentByUrl"},
+	{method: "DELETE", path: "/enrollments/{userId}/all", operation: "DeleteAllEnrollments"},
+	{method: "DELETE", path: "/enrollments/voice/{userId}/{voiceEnrollmentId}", operation: "DeleteVoiceEnrollment"},
+	{method: "DELETE", path: "/enrollments/face/{userId}/{faceEnrollmentId}", operation: "DeleteFaceEnrollment"},
+	{method: "DELETE", path: "/enrollments/video/{userId}/{videoEnrollmentId}", operation: "DeleteVideoEnrollment"},
+	{method: "POST", path: "/verification/voice", operation: "VoiceVerification"},
+	{method: "POST", path: "/verification/voice", operation: "VoiceVerificationByByteSlice"},
+	{method: "POST", path: "/verification/voice/byUrl", operation: "VoiceVerificationByUrl"},
+	{method: "POST", path: "/verification/face", operation: "FaceVerification"},
+	{method: "POST", path: "/verification/face", operation: "FaceVerificationByByteSlice"},
+	{method: "POST", path: "/verification/face/byUrl", operation: "FaceVerificationByUrl"},
+	{method: "POST", path: "/verification/video", operation: "VideoVerification"},
+	{method: "POST", path: "/verification/video", operation: "VideoVerificationByByteSlice"},
+	{method: "POST", path: "/verification/video", operation: "SplitVideoVerification"},
+	{method: "POST", path: "/verification/video", operation: "SplitVideoVerificationByByteSlice"},
+	{method: "POST", path: "/verification/video/byUrl", operation: "VideoVerificationByUrl"},
+	{method: "POST", path: "/identification/voice", operation: "VoiceIdentification"},
+	{method: "POST", path: "/identification/voice", operation: "VoiceIdentificationByByteSlice"},
+	{method: "POST", path: "/identification/voice/byUrl", operation: "VoiceIdentificationByUrl"},
+	{method: "POST", path: "/identification/video", operation: "VideoIdentification"},
+	{method: "POST", path: "/identification/video", operation: "VideoIdentificationByByteSlice"},
+	{method: "POST", path: "/identification/video", operation: "SplitVideoIdentification"},
+	{method: "POST", path: "/identification/video", operation: "SplitVideoIdentificationByByteSlice"},
+	{method: "POST", path: "/identification/video/byUrl", operation: "VideoIdentificationByUrl"},
+	{method: "POST", path: "/identification/face", operation: "FaceIdentification"},
+	{method: "POST", path: "/identification/face", operation: "FaceIdentificationByByteSlice"},
+	{method: "POST", path: "/identification/face/byUrl", operation: "FaceIdentificationByUrl"},
+	{method: "GET", path: "/phrases/{contentLanguage}", operation: "GetPhrases"},
+	{method: "POST", path: "/users/{userId}/token", operation: "CreateUserToken"},
+	{method: "POST", path: "/users/{userId}/expireTokens", operation: "ExpireUserTokens"},
+	{method: "POST", path: "/subaccount/managed", operation: "CreateManagedSubAccount"},
+	{method: "POST", path: "/subaccount/unmanaged", operation: "CreateUnmanagedSubAccount"},
+	{method: "POST", path: "/subaccount/{subAccountAPIKey}", operation: "RegenerateSubAccountAPIToken"},
+	{method: "DELETE", path: "/subaccount/{subAccountAPIKey}", operation: "DeleteSubAccount"},
+	{method: "POST", path: "/subaccount/{subAccountAPIKey}/switchType", operation: "SwitchSubAccountType"},
+}
+
+// defaultResponse returns the response served for operation when no
+// handler is registered: a successful, otherwise empty, return struct
+func defaultResponse(operation string) interface{} {
+	switch operation {
+	case "AddUserToGroup":
+		return &structs.AddUserToGroupReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "CheckGroupExists":
+		return &structs.CheckGroupExistsReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "CheckUserExists":
+		return &structs.CheckUserExistsReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "CreateFaceEnrollment", "CreateFaceEnrollmentByByteSlice", "CreateFaceEnrollmentByUrl":
+		return &structs.CreateFaceEnrollmentReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "CreateGroup":
+		return &structs.CreateGroupReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "CreateManagedSubAccount", "CreateUnmanagedSubAccount":
+		return &structs.CreateSubAccountReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "CreateUser":
+		return &structs.CreateUserReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "CreateUserToken":
+		return &structs.CreateUserTokenReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "CreateVideoEnrollment", "CreateVideoEnrollmentByByteSlice", "CreateSplitVideoEnrollment", "CreateSplitVideoEnrollmentByByteSlice", "CreateVideoEnrollmentByUrl":
+		return &structs.CreateVideoEnrollmentReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "CreateVoiceEnrollment", "CreateVoiceEnrollmentByByteSlice", "CreateVoiceEnrollmentByUrl":
+		return &structs.CreateVoiceEnrollmentReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "DeleteAllEnrollments":
+		return &structs.DeleteAllEnrollmentsReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "DeleteFaceEnrollment":
+		return &structs.DeleteFaceEnrollmentReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "DeleteGroup":
+		return &structs.DeleteGroupReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "DeleteSubAccount":
+		return &structs.DeleteSubAccountReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "DeleteUser":
+		return &structs.DeleteUserReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "DeleteVideoEnrollment":
+		return &structs.DeleteVideoEnrollmentReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "DeleteVoiceEnrollment":
+		return &structs.DeleteVoiceEnrollmentReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "ExpireUserTokens":
+		return &structs.ExpireUserTokensReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "FaceIdentification", "FaceIdentificationByByteSlice", "FaceIdentificationByUrl":
+		return &structs.FaceIdentificationReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "FaceVerification", "FaceVerificationByByteSlice", "FaceVerificationByUrl":
+		return &structs.FaceVerificationReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "GetAllFaceEnrollments":
+		return &structs.GetAllFaceEnrollmentsReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "GetAllGroups":
+		return &structs.GetAllGroupsReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "GetAllUsers":
+		return &structs.GetAllUsersReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "GetAllVideoEnrollments":
+		return &structs.GetAllVideoEnrollmentsReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "GetAllVoiceEnrollments":
+		return &structs.GetAllVoiceEnrollmentsReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "GetGroup":
+		return &structs.GetGroupReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "GetGroupsForUser":
+		return &structs.GetGroupsForUserReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "GetPhrases":
+		return &structs.GetPhrasesReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "RegenerateSubAccountAPIToken":
+		return &structs.RegenerateSubAccountAPITokenReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "RemoveUserFromGroup":
+		return &structs.RemoveUserFromGroupReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "SwitchSubAccountType":
+		return &structs.SwitchSubAccountTypeReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "VideoIdentification", "VideoIdentificationByByteSlice", "SplitVideoIdentification", "SplitVideoIdentificationByByteSlice", "VideoIdentificationByUrl":
+		return &structs.VideoIdentificationReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "VideoVerification", "VideoVerificationByByteSlice", "SplitVideoVerification", "SplitVideoVerificationByByteSlice", "VideoVerificationByUrl":
+		return &structs.VideoVerificationReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "VoiceIdentification", "VoiceIdentificationByByteSlice", "VoiceIdentificationByUrl":
+		return &structs.VoiceIdentificationReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	case "VoiceVerification", "VoiceVerificationByByteSlice", "VoiceVerificationByUrl":
+		return &structs.VoiceVerificationReturn{Envelope: structs.Envelope{Status: 200, ResponseCode: "SUCC"}}
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+// Package voiceit2test provides a fake VoiceIt API server for tests. It
+// serves every endpoint of the API, answering with a successful empty
+// response unless a handler is registered for the operation
+package voiceit2test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+// HandlerFunc answers a call to an endpoint. params holds the path
+// parameters and the non-file form fields of the request. The returned body
+// is encoded as JSON
+type HandlerFunc func(r *http.Request, params map[string]string) (status int, body interface{})
+
+// Request is a request received by the Server
+type Request struct {
+	// Operation is the first operation declared for the endpoint called, e.g.
+	// CreateVoiceEnrollment for the ByByteSlice variant as well
+	Operation string
+	Method    string
+	Path      string
+	Query     string
+	Params    map[string]string
+}
+
+// Server is a fake VoiceIt API. Point a client at it with its URL, e.g.
+// voiceit2.NewClient(key, token, server.URL)
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	handlers map[string]HandlerFunc
+	requests []Request
+}
+
+type route struct {
+	method    string
+	path      string
+	operation string
+}
+
+func (rt route) key() string {
+	return rt.method + " " + rt.path
+}
+
+// NewServer starts a Server. Close it once done
+func NewServer() *Server {
+	s := &Server{handlers: make(map[string]HandlerFunc)}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// Handle registers h to answer calls to the endpoint of operation, e.g.
+// "CreateUser". Operations sharing an endpoint, such as the ByByteSlice
+// variants, share their handler. Handle panics if operation is unknown
+func (s *Server) Handle(operation string, h HandlerFunc) {
+	for _, rt := range routes {
+		if rt.operation == operation {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.handlers[rt.key()] = h
+			return
+		}
+	}
+	panic("voiceit2test: unknown operation " + operation)
+}
+
+// Requests returns the requests received so far, in order
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	rt, params, ok := match(r.Method, r.URL.Path)
+	if !ok {
+		writeJSON(w, http.StatusNotFound, map[string]interface{}{
+			"status":       http.StatusNotFound,
+			"responseCode": "NFEF",
+			"message":      "no endpoint for " + r.Method + " " + r.URL.Path,
+		})
+		return
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		if err := r.ParseMultipartForm(32 << 20); err == nil {
+			for field, values := range r.MultipartForm.Value {
+				params[field] = values[0]
+			}
+		}
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{Operation: rt.operation, Method: r.Method, Path: r.URL.Path, Query: r.URL.RawQuery, Params: params})
+	h := s.handlers[rt.key()]
+	s.mu.Unlock()
+
+	if h == nil {
+		writeJSON(w, http.StatusOK, defaultResponse(rt.operation))
+		return
+	}
+	status, body := h(r, params)
+	writeJSON(w, status, body)
+}
+
+// match finds the route serving path. Literal segments take precedence over
+// parameters, so /subaccount/managed is not read as an API key
+func match(method, path string) (route, map[string]string, bool) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	var best route
+	var bestParams map[string]string
+	fewest := -1
+	for _, rt := range routes {
+		if rt.method != method {
+			continue
+		}
+		template := strings.Split(strings.Trim(rt.path, "/"), "/")
+		if len(template) != len(segments) {
+			continue
+		}
+		params := make(map[string]string)
+		for i, part := range template {
+			if strings.HasPrefix(part, "{") {
+				params[strings.Trim(part, "{}")] = segments[i]
+			} else if part != segments[i] {
+				params = nil
+				break
+			}
+		}
+		if params != nil && (fewest < 0 || len(params) < fewest) {
+			best, bestParams, fewest = rt, params, len(params)
+		}
+	}
+	return best, bestParams, fewest >= 0
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
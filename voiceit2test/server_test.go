@@ -0,0 +1,76 @@
+package voiceit2test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/voiceittech/VoiceIt2-Go/v2"
+	"github.com/voiceittech/VoiceIt2-Go/v2/structs"
+)
+
+func TestServer(t *testing.T) {
+	assert := assert.New(t)
+
+	server := NewServer()
+	defer server.Close()
+	myVoiceIt := voiceit2.NewClient("key", "tok", server.URL)
+
+	// Unhandled calls get a successful empty response of the right shape
+	reply, err := myVoiceIt.GetAllUsers()
+	assert.Equal(nil, err)
+	var gau structs.GetAllUsersReturn
+	assert.Equal(nil, json.Unmarshal(reply, &gau))
+	assert.Equal("SUCC", gau.ResponseCode)
+
+	server.Handle("CreateVoiceEnrollment", func(r *http.Request, params map[string]string) (int, interface{}) {
+		return http.StatusCreated, structs.CreateVoiceEnrollmentReturn{Envelope: structs.Envelope{Status: 201, ResponseCode: "SUCC"}, Text: params["phrase"]}
+	})
+	reply, err = myVoiceIt.CreateVoiceEnrollmentByByteSlice("usr_1", "en-US", "my face and voice identify me", "a.wav", []byte("wav"))
+	assert.Equal(nil, err)
+	var cve structs.CreateVoiceEnrollmentReturn
+	assert.Equal(nil, json.Unmarshal(reply, &cve))
+	assert.Equal("my face and voice identify me", cve.Text, "variants share the handler of their endpoint")
+
+	_, err = myVoiceIt.RegenerateSubAccountAPIToken("key_sub")
+	assert.Equal(nil, err)
+	_, err = myVoiceIt.CreateManagedSubAccount(structs.CreateSubAccountRequest{Email: "a@b.c"})
+	assert.Equal(nil, err)
+
+	requests := server.Requests()
+	assert.Len(requests, 4)
+	assert.Equal("CreateVoiceEnrollment", requests[1].Operation)
+	assert.Equal("usr_1", requests[1].Params["userId"])
+	assert.Equal("RegenerateSubAccountAPIToken", requests[2].Operation)
+	assert.Equal("key_sub", requests[2].Params["subAccountAPIKey"])
+	assert.Equal("CreateManagedSubAccount", requests[3].Operation, "literal segments should win over parameters")
+	assert.Equal("a@b.c", requests[3].Params["email"])
+
+	assert.Panics(func() { server.Handle("NoSuchCall", nil) })
+}
+
+func TestNotificationUrlOnEveryEndpoint(t *testing.T) {
+	assert := assert.New(t)
+
+	server := NewServer()
+	defer server.Close()
+	myVoiceIt := voiceit2.NewClient("key", "tok", server.URL)
+	myVoiceIt.AddNotificationUrl("https://voiceit.io")
+
+	myVoiceIt.CreateUserToken("usr_1", 5*time.Minute)
+	myVoiceIt.ExpireUserTokens("usr_1")
+	myVoiceIt.CreateUnmanagedSubAccount(structs.CreateSubAccountRequest{})
+	myVoiceIt.SwitchSubAccountType("key_sub")
+	myVoiceIt.DeleteSubAccount("key_sub")
+
+	for _, request := range server.Requests() {
+		query, err := url.ParseQuery(request.Query)
+		assert.Equal(nil, err)
+		assert.Equal("https://voiceit.io", query.Get("notificationURL"), request.Operation)
+	}
+	query, _ := url.ParseQuery(server.Requests()[0].Query)
+	assert.Equal("300", query.Get("timeOut"))
+}